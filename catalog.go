@@ -35,16 +35,25 @@ func (c *Catalog) withContainer(fn func(*Container)) {
 	c.funcs = append(c.funcs, fn)
 }
 
+// applyTo adds the declarations in the catalog to con.
+func (c *Catalog) applyTo(con *Container) {
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	for _, fn := range c.funcs {
+		con.withContainer(fn)
+	}
+}
+
 // WithCatalog is a ContainerOption that adds the declarations in the catalog to
 // the container.
+//
+// Catalogs are applied after all other options have been applied to the
+// container.
 func WithCatalog(cat *Catalog) ContainerOption {
 	return option{
 		forContainer: func(con *Container) {
-			cat.m.Lock()
-			defer cat.m.Unlock()
-			for _, fn := range cat.funcs {
-				fn(con)
-			}
+			con.catalogs = append(con.catalogs, cat)
 		},
 	}
 }
@@ -0,0 +1,113 @@
+package imbue
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// CollectErrors is a ContainerOption that causes problems with declarations to
+// be recorded instead of causing a panic.
+//
+// Problems include constructor collisions, self-dependencies, cyclic
+// dependencies and decorators added after the value has been constructed. The
+// recorded problems are returned by Container.Err() and by any subsequent call
+// to InvokeX(), GoX(), Get(), Container.Build() or Container.RunAll(), none of
+// which resolve any dependencies while there are such problems.
+//
+// This allows applications that load declarations from third-party catalogs to
+// report every problem at once.
+func CollectErrors() ContainerOption {
+	return option{
+		forContainer: func(con *Container) {
+			con.collect = true
+		},
+	}
+}
+
+// Err returns an error describing any problems with the container's
+// declarations that were recorded because of the CollectErrors() option.
+//
+// It returns nil if there are no such problems.
+func (c *Container) Err() error {
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	if len(c.errors) == 0 {
+		return nil
+	}
+
+	return declarationError(slices.Clone(c.errors))
+}
+
+// recoverDeclarationError records a panic that occurs while adding a
+// declaration to the container as an error, if the panic describes a problem
+// with the declaration.
+//
+// It must be called using defer.
+func (c *Container) recoverDeclarationError() {
+	r := recover()
+	if r == nil {
+		return
+	}
+
+	err, ok := r.(declarationPanic)
+	if !ok {
+		// Only problems with the declarations are recorded. Any other panic
+		// indicates a genuine bug, which must not be hidden.
+		panic(r)
+	}
+
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	c.errors = append(c.errors, err)
+}
+
+// declarationPanic is a panic value that describes a problem with a
+// declaration, such as a constructor collision or a cyclic dependency.
+//
+// Only panics with values of this type are recorded by the CollectErrors()
+// option.
+type declarationPanic string
+
+// declarationPanicf returns a declarationPanic with a formatted message.
+func declarationPanicf(format string, args ...any) declarationPanic {
+	return declarationPanic(fmt.Sprintf(format, args...))
+}
+
+func (p declarationPanic) Error() string {
+	return string(p)
+}
+
+func (p declarationPanic) String() string {
+	return string(p)
+}
+
+// declarationError is returned when there are one or more problems with the
+// declarations in a container.
+type declarationError []error
+
+func (e declarationError) Error() string {
+	message := fmt.Sprintf(
+		"%d error(s) occurred while declaring dependencies:",
+		len(e),
+	)
+
+	for i, err := range e {
+		// Indent any additional lines, such as those that describe the path
+		// of a cyclic dependency.
+		message += fmt.Sprintf(
+			"\n\t%d) %s",
+			i+1,
+			strings.ReplaceAll(err.Error(), "\n", "\n\t"),
+		)
+	}
+
+	return message
+}
+
+// Unwrap returns the problems with the container's declarations.
+func (e declarationError) Unwrap() []error {
+	return e
+}
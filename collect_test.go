@@ -0,0 +1,341 @@
+package imbue_test
+
+import (
+	"context"
+
+	"github.com/dogmatiq/imbue"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("func CollectErrors()", func() {
+	var container *imbue.Container
+
+	BeforeEach(func() {
+		container = imbue.New(imbue.CollectErrors())
+	})
+
+	AfterEach(func() {
+		container.Close()
+	})
+
+	It("records declaration problems instead of panicking", func() {
+		imbue.With0(
+			container,
+			func(ctx imbue.Context) (Concrete1, error) {
+				return "<concrete>", nil
+			},
+		)
+
+		imbue.With0(
+			container,
+			func(ctx imbue.Context) (Concrete1, error) {
+				panic("unexpected call")
+			},
+		)
+
+		imbue.With1(
+			container,
+			func(ctx imbue.Context, dep Concrete2) (Concrete2, error) {
+				panic("unexpected call")
+			},
+		)
+
+		imbue.With1(
+			container,
+			func(ctx imbue.Context, dep Concrete1) (Concrete3, error) {
+				return "<concrete>", nil
+			},
+		)
+
+		imbue.Decorate1(
+			container,
+			func(ctx imbue.Context, v Concrete1, dep Concrete3) (Concrete1, error) {
+				panic("unexpected call")
+			},
+		)
+
+		err := container.Err()
+		Expect(err).To(
+			MatchError(
+				MatchRegexp(
+					`^3 error\(s\) occurred while declaring dependencies:` +
+						`\n\t1\) imbue_test\.Concrete1 constructor \(collect_test\.go:\d+\) collides with existing constructor declared at collect_test\.go:\d+` +
+						`\n\t2\) imbue_test\.Concrete2 constructor \(collect_test\.go:\d+\) depends on itself via parameter 2` +
+						`\n\t3\) imbue_test\.Concrete1 decorator \(collect_test\.go:\d+\) introduces a cyclic dependency:` +
+						`\n\t\t-> imbue_test\.Concrete3 \(collect_test\.go:\d+\), via parameter 3 \(imbue_test\.Concrete3\) of imbue_test\.Concrete1 decorator \(collect_test\.go:\d+\)` +
+						`\n\t\t-> imbue_test\.Concrete1 \(collect_test\.go:\d+\), via parameter 2 \(imbue_test\.Concrete1\) of imbue_test\.Concrete3 constructor \(collect_test\.go:\d+\)$`,
+				),
+			),
+		)
+	})
+
+	It("records decorators that are added too late", func() {
+		imbue.With0(
+			container,
+			func(ctx imbue.Context) (Concrete1, error) {
+				return "<concrete>", nil
+			},
+		)
+
+		_, err := imbue.Get[Concrete1](context.Background(), container)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		imbue.Decorate0(
+			container,
+			func(ctx imbue.Context, v Concrete1) (Concrete1, error) {
+				panic("unexpected call")
+			},
+		)
+
+		Expect(container.Err()).To(
+			MatchError(
+				MatchRegexp(
+					`^1 error\(s\) occurred while declaring dependencies:` +
+						`\n\t1\) cannot add imbue_test\.Concrete1 decorator \(collect_test\.go:\d+\) because the value has already been constructed$`,
+				),
+			),
+		)
+	})
+
+	It("does not record the dependencies of a rejected constructor", func() {
+		imbue.With0(
+			container,
+			func(ctx imbue.Context) (Concrete1, error) {
+				return "<concrete>", nil
+			},
+		)
+
+		imbue.With1(
+			container,
+			func(ctx imbue.Context, dep Concrete3) (Concrete1, error) {
+				panic("unexpected call")
+			},
+		)
+
+		imbue.With1(
+			container,
+			func(ctx imbue.Context, dep Concrete1) (Concrete2, error) {
+				return "<concrete>", nil
+			},
+		)
+
+		imbue.Decorate2(
+			container,
+			func(ctx imbue.Context, v Concrete1, dep1 Concrete3, dep2 Concrete2) (Concrete1, error) {
+				panic("unexpected call")
+			},
+		)
+
+		Expect(container.Err()).To(HaveOccurred())
+		Expect(container.Graph().Edges).To(Equal([]imbue.GraphEdge{
+			{
+				From: "github.com/dogmatiq/imbue_test.Concrete2",
+				To:   "github.com/dogmatiq/imbue_test.Concrete1",
+			},
+		}))
+	})
+
+	It("does not apply a rejected DecorateAll() decorator to any declaration", func() {
+		imbue.With0(
+			container,
+			func(ctx imbue.Context) (*Labeled1, error) {
+				return &Labeled1{"<label-1>"}, nil
+			},
+		)
+
+		imbue.With0(
+			container,
+			func(ctx imbue.Context) (*Labeled2, error) {
+				return &Labeled2{"<label-2>"}, nil
+			},
+		)
+
+		_, err := imbue.Get[*Labeled1](context.Background(), container)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		imbue.DecorateAll(
+			container,
+			func(ctx imbue.Context, v labeler) (labeler, error) {
+				panic("unexpected call")
+			},
+		)
+
+		Expect(container.Err()).To(
+			MatchError(
+				MatchRegexp(
+					`^1 error\(s\) occurred while declaring dependencies:` +
+						`\n\t1\) cannot add \*imbue_test\.Labeled1 decorator \(collect_test\.go:\d+\) because the value has already been constructed$`,
+				),
+			),
+		)
+
+		for _, n := range container.Graph().Nodes {
+			Expect(n.Decorators).To(BeEmpty())
+		}
+	})
+
+	It("records problems with declarations in catalogs", func() {
+		cat := imbue.NewCatalog()
+
+		imbue.With1(
+			cat,
+			func(ctx imbue.Context, dep Concrete1) (Concrete1, error) {
+				panic("unexpected call")
+			},
+		)
+
+		con := imbue.New(
+			imbue.CollectErrors(),
+			imbue.WithCatalog(cat),
+		)
+		defer con.Close()
+
+		Expect(con.Err()).To(
+			MatchError(
+				MatchRegexp(
+					`^1 error\(s\) occurred while declaring dependencies:` +
+						`\n\t1\) imbue_test\.Concrete1 constructor \(collect_test\.go:\d+\) depends on itself via parameter 2$`,
+				),
+			),
+		)
+	})
+
+	It("returns the problems from InvokeX()", func() {
+		imbue.With1(
+			container,
+			func(ctx imbue.Context, dep Concrete1) (Concrete1, error) {
+				panic("unexpected call")
+			},
+		)
+
+		imbue.With0(
+			container,
+			func(ctx imbue.Context) (Concrete2, error) {
+				panic("unexpected call")
+			},
+		)
+
+		err := imbue.Invoke1(
+			context.Background(),
+			container,
+			func(ctx context.Context, dep Concrete2) error {
+				panic("unexpected call")
+			},
+		)
+		Expect(err).To(Equal(container.Err()))
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("returns the problems from Invoke0()", func() {
+		imbue.With1(
+			container,
+			func(ctx imbue.Context, dep Concrete1) (Concrete1, error) {
+				panic("unexpected call")
+			},
+		)
+
+		err := imbue.Invoke0(
+			context.Background(),
+			container,
+			func(ctx context.Context) error {
+				panic("unexpected call")
+			},
+		)
+		Expect(err).To(Equal(container.Err()))
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("returns the problems from Go0()", func() {
+		imbue.With1(
+			container,
+			func(ctx imbue.Context, dep Concrete1) (Concrete1, error) {
+				panic("unexpected call")
+			},
+		)
+
+		g := container.WaitGroup(context.Background())
+
+		imbue.Go0(
+			g,
+			func(ctx context.Context) error {
+				panic("unexpected call")
+			},
+		)
+
+		err := g.Wait()
+		Expect(err).To(MatchError(container.Err()))
+	})
+
+	It("returns the problems from Get()", func() {
+		imbue.With1(
+			container,
+			func(ctx imbue.Context, dep Concrete1) (Concrete1, error) {
+				panic("unexpected call")
+			},
+		)
+
+		imbue.With0(
+			container,
+			func(ctx imbue.Context) (Concrete2, error) {
+				panic("unexpected call")
+			},
+		)
+
+		_, err := imbue.Get[Concrete2](context.Background(), container)
+		Expect(err).To(Equal(container.Err()))
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("returns the problems from Container.Build()", func() {
+		imbue.With1(
+			container,
+			func(ctx imbue.Context, dep Concrete1) (Concrete1, error) {
+				panic("unexpected call")
+			},
+		)
+
+		imbue.With0(
+			container,
+			func(ctx imbue.Context) (Concrete2, error) {
+				panic("unexpected call")
+			},
+			imbue.Eager(),
+		)
+
+		err := container.Build(context.Background())
+		Expect(err).To(Equal(container.Err()))
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("returns the problems from Container.RunAll()", func() {
+		imbue.With1(
+			container,
+			func(ctx imbue.Context, dep Concrete1) (Concrete1, error) {
+				panic("unexpected call")
+			},
+		)
+
+		imbue.With0(
+			container,
+			func(ctx imbue.Context) (Runner1, error) {
+				panic("unexpected call")
+			},
+		)
+
+		err := container.RunAll(context.Background())
+		Expect(err).To(Equal(container.Err()))
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("returns nil from Err() if there are no problems", func() {
+		imbue.With0(
+			container,
+			func(ctx imbue.Context) (Concrete1, error) {
+				return "<concrete>", nil
+			},
+		)
+
+		Expect(container.Err()).ShouldNot(HaveOccurred())
+	})
+})
@@ -0,0 +1,242 @@
+// Package config declares configuration structs whose fields are populated
+// from environment variables.
+//
+// Each exported field of the struct is populated from an environment variable
+// whose name is the field name converted to SCREAMING_SNAKE_CASE, optionally
+// preceded by a prefix. For example, a field named ListenAddress is populated
+// from the LISTEN_ADDRESS environment variable.
+//
+// The following struct tags change how a field is populated:
+//
+//   - env:"NAME" uses NAME instead of the name derived from the field name, or
+//     env:"-" to ignore the field entirely
+//   - default:"VALUE" uses VALUE when the variable is not defined
+//   - required:"true" causes an error if the variable is not defined
+//
+// Fields that are structs themselves are populated recursively, using the
+// field's variable name as a prefix. Embedded structs do not add a prefix.
+package config
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+
+	"github.com/dogmatiq/imbue"
+	"github.com/dogmatiq/imbue/internal/identifier"
+	"github.com/dogmatiq/imbue/internal/parse"
+)
+
+// Option is an option that changes how a configuration struct is loaded.
+type Option interface {
+	applyOption(*options)
+}
+
+// options is the set of options that apply to loading a configuration struct.
+type options struct {
+	prefix string
+	lookup func(string) (string, bool)
+}
+
+// newOptions returns the options described by the given options.
+func newOptions(opts []Option) options {
+	o := options{
+		lookup: os.LookupEnv,
+	}
+
+	for _, opt := range opts {
+		opt.applyOption(&o)
+	}
+
+	return o
+}
+
+// option is an implementation of Option.
+type option func(*options)
+
+func (o option) applyOption(opts *options) {
+	o(opts)
+}
+
+// Prefix is an Option that adds a prefix to the name of every environment
+// variable.
+//
+// The prefix is converted to SCREAMING_SNAKE_CASE and separated from the rest
+// of the name by an underscore. For example, Prefix("myApp") causes a field
+// named ListenAddress to be populated from MY_APP_LISTEN_ADDRESS.
+func Prefix(p string) Option {
+	return option(func(opts *options) {
+		opts.prefix = identifier.ToScreamingSnakeCase(p)
+	})
+}
+
+// Lookup is an Option that uses fn to obtain the values of environment
+// variables, instead of os.LookupEnv().
+func Lookup(fn func(name string) (string, bool)) Option {
+	return option(func(opts *options) {
+		opts.lookup = fn
+	})
+}
+
+// Declare declares a constructor for the configuration struct T that populates
+// its fields from environment variables.
+//
+// It panics if T is not a struct.
+func Declare[T any](
+	con imbue.ContainerAware,
+	options ...Option,
+) {
+	if t := reflect.TypeFor[T](); t.Kind() != reflect.Struct {
+		panic(fmt.Sprintf(
+			"cannot declare %s because it is not a struct",
+			t,
+		))
+	}
+
+	imbue.With0(
+		con,
+		func(ctx imbue.Context) (T, error) {
+			return Load[T](options...)
+		},
+	)
+}
+
+// Load returns a configuration struct of type T with its fields populated from
+// environment variables.
+//
+// If any variables are missing or invalid, the returned error describes all of
+// them, not just the first.
+func Load[T any](options ...Option) (T, error) {
+	var cfg T
+
+	rv := reflect.ValueOf(&cfg).Elem()
+	if rv.Kind() != reflect.Struct {
+		panic(fmt.Sprintf(
+			"cannot load %s because it is not a struct",
+			rv.Type(),
+		))
+	}
+
+	l := loader{
+		opts: newOptions(options),
+	}
+
+	l.loadStruct(rv, l.opts.prefix)
+
+	if len(l.missing) != 0 || len(l.invalid) != 0 {
+		return cfg, loadError{
+			Type:    rv.Type(),
+			Missing: l.missing,
+			Invalid: l.invalid,
+		}
+	}
+
+	return cfg, nil
+}
+
+// loader populates the fields of a configuration struct.
+type loader struct {
+	opts    options
+	missing []string
+	invalid []error
+}
+
+// loadStruct populates the fields of the struct v, prefixing each variable
+// name with prefix.
+func (l *loader) loadStruct(v reflect.Value, prefix string) {
+	for i := 0; i < v.NumField(); i++ {
+		f := v.Type().Field(i)
+		if !f.IsExported() {
+			continue
+		}
+
+		name, ok := f.Tag.Lookup("env")
+		if name == "-" {
+			continue
+		}
+		if !ok {
+			name = identifier.ToScreamingSnakeCase(f.Name)
+		}
+
+		if parse.IsNested(f.Type) {
+			if f.Anonymous {
+				l.loadStruct(v.Field(i), prefix)
+			} else {
+				l.loadStruct(v.Field(i), join(prefix, name))
+			}
+			continue
+		}
+
+		l.loadField(v.Field(i), f, join(prefix, name))
+	}
+}
+
+// loadField populates the field v from the environment variable with the
+// given name.
+func (l *loader) loadField(
+	v reflect.Value,
+	f reflect.StructField,
+	name string,
+) {
+	value, ok := l.opts.lookup(name)
+
+	if !ok {
+		if value, ok = f.Tag.Lookup("default"); !ok {
+			if f.Tag.Get("required") == "true" {
+				l.missing = append(l.missing, name)
+			}
+			return
+		}
+	}
+
+	if err := parse.Value(v, value); err != nil {
+		l.invalid = append(
+			l.invalid,
+			fmt.Errorf("%s: cannot parse %q as %s: %w", name, value, f.Type, err),
+		)
+	}
+}
+
+// join returns the variable name formed by joining prefix and name with an
+// underscore.
+func join(prefix, name string) string {
+	if prefix == "" {
+		return name
+	}
+
+	return prefix + "_" + name
+}
+
+// loadError is returned when one or more environment variables are missing or
+// invalid.
+type loadError struct {
+	Type    reflect.Type
+	Missing []string
+	Invalid []error
+}
+
+func (e loadError) Error() string {
+	message := fmt.Sprintf(
+		"unable to load %s:",
+		e.Type,
+	)
+
+	if len(e.Missing) != 0 {
+		message += fmt.Sprintf(
+			"\n\t- required environment variable(s) not defined: %s",
+			strings.Join(e.Missing, ", "),
+		)
+	}
+
+	for _, err := range e.Invalid {
+		message += fmt.Sprintf("\n\t- %s", err)
+	}
+
+	return message
+}
+
+// Unwrap returns the errors that occurred while parsing variables.
+func (e loadError) Unwrap() []error {
+	return e.Invalid
+}
@@ -0,0 +1,190 @@
+package config_test
+
+import (
+	"context"
+	"net/netip"
+	"time"
+
+	"github.com/dogmatiq/imbue"
+	. "github.com/dogmatiq/imbue/config"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+type Config struct {
+	ListenAddress string `required:"true"`
+	Timeout       time.Duration
+	Workers       int     `default:"4"`
+	Verbose       bool    `env:"DEBUG"`
+	Ratio         float64 `default:"0.5"`
+	Ignored       string  `env:"-"`
+	Addr          netip.Addr
+	Database      DatabaseConfig
+	Embedded
+
+	unexported string
+}
+
+type DatabaseConfig struct {
+	DSN     string `required:"true"`
+	MaxConn uint8
+}
+
+type Embedded struct {
+	ServiceName string
+}
+
+// env returns a lookup function that uses the given environment.
+func env(vars map[string]string) Option {
+	return Lookup(func(name string) (string, bool) {
+		v, ok := vars[name]
+		return v, ok
+	})
+}
+
+var _ = Describe("func Load()", func() {
+	It("populates fields from environment variables", func() {
+		cfg, err := Load[Config](
+			env(map[string]string{
+				"LISTEN_ADDRESS":    ":8080",
+				"TIMEOUT":           "10s",
+				"WORKERS":           "8",
+				"DEBUG":             "true",
+				"RATIO":             "0.25",
+				"IGNORED":           "<ignored>",
+				"ADDR":              "127.0.0.1",
+				"DATABASE_DSN":      "<dsn>",
+				"DATABASE_MAX_CONN": "10",
+				"SERVICE_NAME":      "<service>",
+			}),
+		)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(cfg).To(Equal(Config{
+			ListenAddress: ":8080",
+			Timeout:       10 * time.Second,
+			Workers:       8,
+			Verbose:       true,
+			Ratio:         0.25,
+			Addr:          netip.MustParseAddr("127.0.0.1"),
+			Database: DatabaseConfig{
+				DSN:     "<dsn>",
+				MaxConn: 10,
+			},
+			Embedded: Embedded{
+				ServiceName: "<service>",
+			},
+		}))
+	})
+
+	It("uses default values when variables are not defined", func() {
+		cfg, err := Load[Config](
+			env(map[string]string{
+				"LISTEN_ADDRESS": ":8080",
+				"DATABASE_DSN":   "<dsn>",
+			}),
+		)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(cfg.Workers).To(Equal(4))
+		Expect(cfg.Ratio).To(Equal(0.5))
+		Expect(cfg.Timeout).To(BeZero())
+	})
+
+	It("adds the prefix to each variable name", func() {
+		cfg, err := Load[Config](
+			Prefix("myApp"),
+			env(map[string]string{
+				"MY_APP_LISTEN_ADDRESS": ":8080",
+				"MY_APP_DATABASE_DSN":   "<dsn>",
+				"MY_APP_SERVICE_NAME":   "<service>",
+			}),
+		)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(cfg.ListenAddress).To(Equal(":8080"))
+		Expect(cfg.Database.DSN).To(Equal("<dsn>"))
+		Expect(cfg.ServiceName).To(Equal("<service>"))
+	})
+
+	It("reports all missing and invalid variables at once", func() {
+		_, err := Load[Config](
+			env(map[string]string{
+				"WORKERS": "<workers>",
+				"TIMEOUT": "<timeout>",
+			}),
+		)
+		Expect(err).To(MatchError(
+			"unable to load config_test.Config:" +
+				"\n\t- required environment variable(s) not defined: LISTEN_ADDRESS, DATABASE_DSN" +
+				"\n\t- TIMEOUT: cannot parse \"<timeout>\" as time.Duration: time: invalid duration \"<timeout>\"" +
+				"\n\t- WORKERS: cannot parse \"<workers>\" as int: strconv.ParseInt: parsing \"<workers>\": invalid syntax",
+		))
+	})
+
+	It("reads from the process environment by default", func() {
+		GinkgoT().Setenv("LISTEN_ADDRESS", ":8080")
+		GinkgoT().Setenv("DATABASE_DSN", "<dsn>")
+
+		cfg, err := Load[Config]()
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(cfg.ListenAddress).To(Equal(":8080"))
+	})
+
+	It("panics if the type is not a struct", func() {
+		Expect(func() {
+			Load[string]()
+		}).To(PanicWith("cannot load string because it is not a struct"))
+	})
+})
+
+var _ = Describe("func Declare()", func() {
+	It("declares a constructor that loads the configuration", func() {
+		con := imbue.New()
+		defer con.Close()
+
+		Declare[DatabaseConfig](
+			con,
+			env(map[string]string{
+				"DSN": "<dsn>",
+			}),
+		)
+
+		err := imbue.Invoke1(
+			context.Background(),
+			con,
+			func(ctx context.Context, cfg DatabaseConfig) error {
+				Expect(cfg.DSN).To(Equal("<dsn>"))
+				return nil
+			},
+		)
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+
+	It("returns an error from the constructor if the configuration cannot be loaded", func() {
+		con := imbue.New()
+		defer con.Close()
+
+		Declare[DatabaseConfig](
+			con,
+			env(map[string]string{}),
+		)
+
+		err := imbue.Invoke1(
+			context.Background(),
+			con,
+			func(ctx context.Context, cfg DatabaseConfig) error {
+				return nil
+			},
+		)
+		Expect(err).To(MatchError(MatchRegexp(
+			`^config_test\.DatabaseConfig constructor \(config_test\.go:\d+\) failed: unable to load config_test\.DatabaseConfig:`,
+		)))
+	})
+
+	It("panics if the type is not a struct", func() {
+		con := imbue.New()
+		defer con.Close()
+
+		Expect(func() {
+			Declare[int](con)
+		}).To(PanicWith("cannot declare int because it is not a struct"))
+	})
+})
@@ -0,0 +1,47 @@
+package config_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/dogmatiq/imbue"
+	"github.com/dogmatiq/imbue/config"
+)
+
+func ExampleDeclare() {
+	con := imbue.New()
+	defer con.Close()
+
+	// Declare a configuration struct to use within the example.
+	type ServerConfig struct {
+		ListenAddress string `required:"true"`
+		MaxRequests   int    `default:"100"`
+	}
+
+	os.Setenv("EXAMPLE_LISTEN_ADDRESS", ":8080")
+	defer os.Unsetenv("EXAMPLE_LISTEN_ADDRESS")
+
+	// Declare a constructor for ServerConfig that populates its fields from
+	// environment variables prefixed with EXAMPLE_.
+	config.Declare[ServerConfig](
+		con,
+		config.Prefix("example"),
+	)
+
+	// Invoke a function that depends on the configuration.
+	err := imbue.Invoke1(
+		context.Background(),
+		con,
+		func(ctx context.Context, cfg ServerConfig) error {
+			fmt.Println(cfg.ListenAddress, cfg.MaxRequests)
+			return nil
+		},
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	// Output:
+	// :8080 100
+}
@@ -3,6 +3,8 @@ package imbue
 import (
 	"context"
 	"fmt"
+	"reflect"
+	"time"
 )
 
 // constructor is a wrapper around a function that constructs a value of type T.
@@ -24,14 +26,26 @@ type constructor[T any] struct {
 }
 
 // Call invokes the constructor and returns the constructed value.
-func (c constructor[T]) Call(ctx context.Context, defers *deferSet) (T, error) {
+func (c constructor[T]) Call(
+	ctx context.Context,
+	obs Observer,
+	defers *deferSet,
+) (T, error) {
+	info := typeInfoOf(c)
+	ctx = obs.ConstructStart(ctx, info)
+	start := time.Now()
+
 	v, err := c.impl(
 		&scopedContext{
-			Context: ctx,
-			scope:   c,
-			defers:  defers,
+			Context:  ctx,
+			scope:    c,
+			observer: obs,
+			defers:   defers,
 		},
 	)
+
+	obs.ConstructEnd(ctx, info, err, time.Since(start))
+
 	if err != nil {
 		if c.rawErr {
 			return v, err
@@ -47,6 +61,11 @@ func (c constructor[T]) Call(ctx context.Context, defers *deferSet) (T, error) {
 	return v, nil
 }
 
+// Type returns the type of the value constructed by the constructor.
+func (c constructor[T]) Type() reflect.Type {
+	return typeOf[T]()
+}
+
 // Location returns the location of the code that provided the constructor.
 //
 // This is typically the location of the call to the WithX() function, not the
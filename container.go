@@ -21,6 +21,14 @@ type Container struct {
 	m            sync.Mutex
 	declarations map[reflect.Type]declaration
 	defers       deferSet
+	observers    observerSet
+	profiles     map[string]struct{}
+	catalogs     []*Catalog
+	decorateAll  []interfaceDecorator
+	invocations  map[location]invocation
+	sealedAt     *location
+	collect      bool
+	errors       []error
 }
 
 // ContainerOption is an option that changes the behavior of a container or how
@@ -39,18 +47,64 @@ func New(options ...ContainerOption) *Container {
 		opt.applyContainerOption(con)
 	}
 
+	// Catalogs are applied after all other options so that their declarations
+	// observe the container's configuration, regardless of the order in which
+	// the options were supplied.
+	for _, cat := range con.catalogs {
+		cat.applyTo(con)
+	}
+
 	return con
 }
 
+// Build constructs all of the values that were declared using the Eager()
+// option, along with their dependencies.
+//
+// It attempts to construct every eager value, even if some fail. If any of the
+// values can not be constructed, the returned error describes all of the
+// failures.
+func (c *Container) Build(ctx context.Context) error {
+	if err := c.Err(); err != nil {
+		return err
+	}
+
+	c.m.Lock()
+	declarations := sortDeclarations(c.declarations)
+	c.m.Unlock()
+
+	var errors []error
+
+	for _, d := range declarations {
+		if d.IsEager() {
+			if _, err := d.ResolveAny(ctx); err != nil {
+				errors = append(errors, err)
+			}
+		}
+	}
+
+	if len(errors) != 0 {
+		return buildError(errors)
+	}
+
+	return nil
+}
+
 // WaitGroup returns a new WaitGroup that is bound to this container.
+//
+// The context passed to functions started by the wait group carries the wait
+// group itself, such that those functions may start additional functions
+// within the same group. See WaitGroupFromContext().
 func (c *Container) WaitGroup(ctx context.Context) *WaitGroup {
-	g, ctx := errgroup.WithContext(ctx)
+	group, ctx := errgroup.WithContext(ctx)
 
-	return &WaitGroup{
+	g := &WaitGroup{
 		con:   c,
-		ctx:   ctx,
-		group: g,
+		group: group,
 	}
+
+	g.ctx = context.WithValue(ctx, waitGroupKey{}, g)
+
+	return g
 }
 
 // Close closes the container, calling any deferred functions registered
@@ -59,17 +113,57 @@ func (c *Container) Close() error {
 	c.m.Lock()
 	defer c.m.Unlock()
 
-	if errors := c.defers.Call(); len(errors) != 0 {
-		return closeError(errors)
+	var err error
+	if errors := c.defers.Call(c.observers); len(errors) != 0 {
+		err = closeError(errors)
 	}
 
-	return nil
+	c.observers.Closed(err)
+
+	return err
 }
 
 func (c *Container) withContainer(fn func(*Container)) {
+	c.m.Lock()
+	sealedAt := c.sealedAt
+	collect := c.collect
+	c.m.Unlock()
+
+	if collect {
+		defer c.recoverDeclarationError()
+	}
+
+	if sealedAt != nil {
+		panic(declarationPanicf(
+			"cannot modify the container at %s because it was sealed at %s",
+			findLocation(),
+			*sealedAt,
+		))
+	}
+
 	fn(c)
 }
 
+// Seal prevents any further constructors or decorators from being added to
+// the container.
+//
+// Once the container is sealed, any call to WithX(), DecorateX() or
+// DecorateAll() with the container panics. Sealing the container after it has
+// been fully wired exposes code that adds declarations too late, which would
+// otherwise succeed silently.
+//
+// Sealing an already-sealed container has no effect.
+func (c *Container) Seal() {
+	loc := findLocation()
+
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	if c.sealedAt == nil {
+		c.sealedAt = &loc
+	}
+}
+
 // typeOf returns the reflect.Type for T.
 func typeOf[T any]() reflect.Type {
 	return reflect.TypeOf([0]T{}).Elem()
@@ -87,14 +181,20 @@ func get[T any](con *Container) *declarationOf[T] {
 	}
 
 	d := &declarationOf[T]{
-		defers: &con.defers,
+		defers:   &con.defers,
+		observer: con.observers,
 	}
 	con.declarations[t] = d
+	decorateAll := con.decorateAll
 
 	con.m.Unlock()
 
 	d.Init(con)
 
+	for _, dec := range decorateAll {
+		d.DecorateAll(dec)
+	}
+
 	return d
 }
 
@@ -102,6 +202,7 @@ func get[T any](con *Container) *declarationOf[T] {
 func (c *Container) String() string {
 	c.m.Lock()
 	declarations := sortDeclarations(c.declarations)
+	invocations := sortInvocations(c.invocations)
 	c.m.Unlock()
 
 	tree := treeprint.New()
@@ -113,6 +214,14 @@ func (c *Container) String() string {
 		}
 	}
 
+	for _, inv := range invocations {
+		sub := tree.AddBranch(inv.String())
+
+		for _, dep := range inv.deps {
+			buildTree(sub, dep)
+		}
+	}
+
 	return tree.String()
 }
 
@@ -150,6 +259,28 @@ func sortDeclarations(declarations map[reflect.Type]declaration) []declaration {
 	return sorted
 }
 
+// buildError is returned when there are one or more errors building a
+// container.
+type buildError []error
+
+func (e buildError) Error() string {
+	message := fmt.Sprintf(
+		"%d error(s) occurred while building the container:",
+		len(e),
+	)
+
+	for i, err := range e {
+		message += fmt.Sprintf("\n\t%d) %s", i+1, err)
+	}
+
+	return message
+}
+
+// Unwrap returns the errors that occurred while building the container.
+func (e buildError) Unwrap() []error {
+	return e
+}
+
 // closeError is returned when there are one or more errors closing a container.
 type closeError []error
 
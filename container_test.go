@@ -1,11 +1,16 @@
 package imbue_test
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime"
 	"strings"
 
 	"github.com/dogmatiq/imbue"
 	"github.com/google/go-cmp/cmp"
 	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
 )
 
 var _ = Describe("type Container", func() {
@@ -106,6 +111,235 @@ var _ = Describe("type Container", func() {
 				"        └── imbue_test.Concrete3",
 			)
 		})
+
+		It("renders calls to InvokeX() and GoX() as roots", func() {
+			imbue.With1(
+				container,
+				func(
+					imbue.Context,
+					Concrete2,
+				) (Concrete1, error) {
+					return "<concrete-1>", nil
+				},
+			)
+
+			imbue.With0(
+				container,
+				func(
+					imbue.Context,
+				) (Concrete2, error) {
+					return "<concrete-2>", nil
+				},
+			)
+
+			_, _, invokeLine, _ := runtime.Caller(0)
+			err := imbue.Invoke1(
+				context.Background(),
+				container,
+				func(context.Context, Concrete1) error {
+					return nil
+				},
+			)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			g := container.WaitGroup(context.Background())
+			_, _, goLine, _ := runtime.Caller(0)
+			imbue.Go1(
+				g,
+				func(context.Context, Concrete2) error {
+					return nil
+				},
+			)
+			Expect(g.Wait()).To(Succeed())
+
+			expectMultilineString(
+				container,
+				"<container>",
+				"├── imbue_test.Concrete1",
+				"│   └── imbue_test.Concrete2",
+				fmt.Sprintf("├── <invoke> (container_test.go:%d)", invokeLine+1),
+				"│   └── imbue_test.Concrete1",
+				"│       └── imbue_test.Concrete2",
+				fmt.Sprintf("└── <go> (container_test.go:%d)", goLine+1),
+				"    └── imbue_test.Concrete2",
+			)
+		})
+	})
+
+	Describe("func Build()", func() {
+		It("constructs eager values and their dependencies", func() {
+			var constructed []string
+
+			imbue.With0(
+				container,
+				func(ctx imbue.Context) (Concrete1, error) {
+					constructed = append(constructed, "<concrete-1>")
+					return "<concrete-1>", nil
+				},
+			)
+
+			imbue.With1(
+				container,
+				func(ctx imbue.Context, dep Concrete1) (Concrete2, error) {
+					constructed = append(constructed, "<concrete-2>")
+					return "<concrete-2>", nil
+				},
+				imbue.Eager(),
+			)
+
+			imbue.With0(
+				container,
+				func(ctx imbue.Context) (Concrete3, error) {
+					constructed = append(constructed, "<concrete-3>")
+					return "<concrete-3>", nil
+				},
+			)
+
+			err := container.Build(context.Background())
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(constructed).To(Equal([]string{"<concrete-1>", "<concrete-2>"}))
+		})
+
+		It("returns an error describing every failure", func() {
+			imbue.With0(
+				container,
+				func(ctx imbue.Context) (Concrete1, error) {
+					return "", errors.New("<error-1>")
+				},
+				imbue.Eager(),
+			)
+
+			imbue.With0(
+				container,
+				func(ctx imbue.Context) (Concrete2, error) {
+					return "<concrete-2>", nil
+				},
+				imbue.Eager(),
+			)
+
+			imbue.With1(
+				container,
+				func(ctx imbue.Context, dep Concrete1) (Concrete3, error) {
+					panic("unexpected call")
+				},
+			)
+
+			imbue.With0Named[Foreground](
+				container,
+				func(ctx imbue.Context) (Color, error) {
+					return "", errors.New("<error-2>")
+				},
+				imbue.Eager(),
+			)
+
+			err := container.Build(context.Background())
+			Expect(err).To(
+				MatchError(
+					MatchRegexp(
+						`^2 error\(s\) occurred while building the container:` +
+							`\n\t1\) imbue\.ByName\[github\.com/dogmatiq/imbue_test\.Foreground,github\.com/dogmatiq/imbue_test\.Color\] constructor \(container_test\.go:\d+\) failed: <error-2>` +
+							`\n\t2\) imbue_test\.Concrete1 constructor \(container_test\.go:\d+\) failed: <error-1>$`,
+					),
+				),
+			)
+		})
+	})
+
+	Describe("func Seal()", func() {
+		BeforeEach(func() {
+			imbue.With0(
+				container,
+				func(ctx imbue.Context) (Concrete1, error) {
+					return "<concrete>", nil
+				},
+			)
+		})
+
+		It("causes WithX() to panic", func() {
+			container.Seal()
+
+			Expect(func() {
+				imbue.With0(
+					container,
+					func(ctx imbue.Context) (Concrete2, error) {
+						panic("unexpected call")
+					},
+				)
+			}).To(PanicWith(
+				MatchRegexp(
+					`^cannot modify the container at container_test\.go:\d+ because it was sealed at container_test\.go:\d+$`,
+				),
+			))
+		})
+
+		It("causes DecorateX() to panic", func() {
+			container.Seal()
+
+			Expect(func() {
+				imbue.Decorate0(
+					container,
+					func(ctx imbue.Context, v Concrete1) (Concrete1, error) {
+						panic("unexpected call")
+					},
+				)
+			}).To(PanicWith(
+				MatchRegexp(
+					`^cannot modify the container at container_test\.go:\d+ because it was sealed at container_test\.go:\d+$`,
+				),
+			))
+		})
+
+		It("causes DecorateAll() to panic", func() {
+			container.Seal()
+
+			Expect(func() {
+				imbue.DecorateAll(
+					container,
+					func(ctx imbue.Context, v fmt.Stringer) (fmt.Stringer, error) {
+						panic("unexpected call")
+					},
+				)
+			}).To(PanicWith(
+				MatchRegexp(
+					`^cannot modify the container at container_test\.go:\d+ because it was sealed at container_test\.go:\d+$`,
+				),
+			))
+		})
+
+		It("reports the location of the first call to Seal()", func() {
+			_, _, line, _ := runtime.Caller(0)
+			container.Seal()
+			container.Seal()
+
+			Expect(func() {
+				imbue.With0(
+					container,
+					func(ctx imbue.Context) (Concrete2, error) {
+						panic("unexpected call")
+					},
+				)
+			}).To(PanicWith(
+				HaveSuffix(fmt.Sprintf("sealed at container_test.go:%d", line+1)),
+			))
+		})
+
+		It("does not prevent dependencies from being resolved", func() {
+			container.Seal()
+
+			err := imbue.Invoke2(
+				context.Background(),
+				container,
+				func(
+					ctx context.Context,
+					dep1 Concrete1,
+					dep2 imbue.Optional[Concrete2],
+				) error {
+					Expect(dep1).To(Equal(Concrete1("<concrete>")))
+					return nil
+				},
+			)
+			Expect(err).ShouldNot(HaveOccurred())
+		})
 	})
 })
 
@@ -18,17 +18,19 @@ type Context interface {
 type scopedContext struct {
 	context.Context
 
-	scope  userFunction
-	defers *deferSet
+	scope    userFunction
+	observer Observer
+	defers   *deferSet
 }
 
 // Defer registers a function to be invoked when the container is closed.
 func (c *scopedContext) Defer(fn func() error) {
-	c.defers.Add(
-		deferred{
-			fn,
-			findLocation(),
-			c.scope,
-		},
-	)
+	d := deferred{
+		fn,
+		findLocation(),
+		c.scope,
+	}
+
+	c.defers.Add(d)
+	c.observer.Deferred(c, typeInfoOf(d))
 }
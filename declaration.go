@@ -4,7 +4,11 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"slices"
+	"sort"
 	"sync"
+	"sync/atomic"
+	"time"
 )
 
 // declaration is an interface that describes how to build a value of a specific
@@ -13,6 +17,12 @@ type declaration interface {
 	// Type returns the type of the value constructed by this declaration.
 	Type() reflect.Type
 
+	// ValueType returns the type of the dependency value.
+	//
+	// For named and grouped dependencies, this is the type of the underlying
+	// value, not the ByName or FromGroup type.
+	ValueType() reflect.Type
+
 	// BestLocation returns the "best" known location of the declaration in
 	// code.
 	//
@@ -24,6 +34,9 @@ type declaration interface {
 	// IsDependency returns true if other declarations depend upon this one.
 	IsDependency() bool
 
+	// IsDeclared returns true if a constructor has been declared.
+	IsDeclared() bool
+
 	// Dependencies returns the declarations that this declaration depends upon,
 	// sorted by type.
 	Dependencies() []declaration
@@ -37,6 +50,59 @@ type declaration interface {
 	// MarkAsDependency marks the declaration as a dependency. That is, other
 	// declarations depend upon this one.
 	MarkAsDependency()
+
+	// DecorateAll adds dec as a decorator of this declaration's type, if the
+	// type implements dec's interface.
+	DecorateAll(dec interfaceDecorator)
+
+	// CheckDecorateAll panics if DecorateAll() would panic when passed dec.
+	//
+	// It does not lock the declaration, so it may be called while the
+	// container's mutex is held.
+	CheckDecorateAll(dec interfaceDecorator)
+
+	// IsEager returns true if the value should be constructed when the
+	// container is built.
+	IsEager() bool
+
+	// ResolveAny returns the value constructed by this declaration.
+	ResolveAny(ctx context.Context) (any, error)
+
+	// ConstructedValue returns the value constructed by this declaration, if
+	// it has already been constructed. It does not wait for a value that is
+	// currently being constructed.
+	ConstructedValue() (any, bool)
+
+	// DecoratorLocations returns the locations of the declaration's
+	// decorators, in the order they are applied.
+	DecoratorLocations() []location
+
+	// DependencyOn returns the dependency through which this declaration
+	// (possibly indirectly, via an implicit declaration) depends upon t.
+	DependencyOn(t declaration) (dependency, bool)
+}
+
+// dependency describes a dependency of one declaration upon another.
+type dependency struct {
+	// Declaration is the declaration that is depended upon.
+	Declaration declaration
+
+	// Scope is the user function that introduced the dependency.
+	Scope userFunction
+
+	// Param is the position of the parameter of Scope through which the
+	// dependency is introduced, starting at 1.
+	Param int
+}
+
+// String returns a description of how the dependency was introduced.
+func (d dependency) String() string {
+	return fmt.Sprintf(
+		"parameter %d (%s) of %s",
+		d.Param,
+		d.Declaration.Type(),
+		d.Scope,
+	)
 }
 
 // findPath returns the path from t to d, where d is a (possibly indirect)
@@ -67,11 +133,16 @@ func findPath(t, d declaration) []declaration {
 type declarationOf[T any] struct {
 	m               sync.Mutex
 	defers          *deferSet
+	observer        Observer
 	initLocation    location
 	isSelfDeclaring bool
 	isDeclared      bool
-	isConstructed   bool
-	deps            map[reflect.Type]declaration
+	isEager         bool
+	timeout         time.Duration
+	retry           RetryPolicy
+	abandoned       chan struct{}
+	isConstructed   atomic.Bool
+	deps            map[reflect.Type]dependency
 	isDep           bool
 	constructor     constructor[T]
 	decorators      []decorator[T]
@@ -81,6 +152,7 @@ type declarationOf[T any] struct {
 // userFunction is an interface for a user-supplied function that forms part of
 // the life-cycle of a specific type, such as constructors and decorators.
 type userFunction interface {
+	Type() reflect.Type
 	Location() location
 	String() string
 }
@@ -110,6 +182,7 @@ func (d *declarationOf[T]) Init(con *Container) {
 // Declare declares a constructor for values of type T.
 func (d *declarationOf[T]) Declare(
 	impl func(Context) (T, error),
+	opts withOptions,
 	deps ...declaration,
 ) {
 	ctor := constructor[T]{
@@ -118,99 +191,249 @@ func (d *declarationOf[T]) Declare(
 		d.isSelfDeclaring,
 	}
 
-	for _, dep := range deps {
-		d.dependsOn(dep, ctor)
+	// Validate the declaration in full before modifying the dependency graph,
+	// so that a rejected declaration leaves no trace when the panic is
+	// recovered by the CollectErrors() option.
+	d.checkCollision(ctor)
+	dependencies := d.checkDependencies(ctor, 2, deps) // skip the context parameter
+
+	for _, dep := range dependencies {
+		d.addDependency(dep)
 	}
 
 	d.m.Lock()
 	defer d.m.Unlock()
 
-	if d.isDeclared {
-		isSelfDeclaring := d.isSelfDeclaring
+	d.isDeclared = true
+	d.isEager = opts.isEager
+	d.timeout = opts.timeout
+	d.retry = opts.retry
+	d.constructor = ctor
+}
 
-		if isSelfDeclaring {
-			panic(fmt.Sprintf(
-				"explicit declaration of %s is disallowed",
-				ctor,
-			))
-		}
+// checkCollision panics if a constructor has already been declared for T.
+func (d *declarationOf[T]) checkCollision(ctor constructor[T]) {
+	d.m.Lock()
+	defer d.m.Unlock()
+
+	if !d.isDeclared {
+		return
+	}
 
-		panic(fmt.Sprintf(
-			"%s collides with existing constructor declared at %s",
+	if d.isSelfDeclaring {
+		panic(declarationPanicf(
+			"explicit declaration of %s is disallowed",
 			ctor,
-			d.constructor.Location(),
 		))
 	}
 
-	d.isDeclared = true
-	d.constructor = ctor
+	panic(declarationPanicf(
+		"%s collides with existing constructor declared at %s",
+		ctor,
+		d.constructor.Location(),
+	))
 }
 
 // Decorate adds a decorator function that is called after T's constructor.
 func (d *declarationOf[T]) Decorate(
 	impl func(Context, T) (T, error),
+	opts decorateOptions,
 	deps ...declaration,
 ) {
-	dec := decorator[T]{
-		impl,
-		findLocation(),
+	d.addDecorator(
+		decorator[T]{
+			impl,
+			findLocation(),
+			opts.priority,
+		},
+		deps...,
+	)
+}
+
+// DecorateAll adds dec as a decorator of T, if T implements dec's interface.
+func (d *declarationOf[T]) DecorateAll(dec interfaceDecorator) {
+	if dec, ok := d.interfaceDecorator(dec); ok {
+		d.addDecorator(dec)
 	}
+}
+
+// CheckDecorateAll panics if DecorateAll() would panic when passed dec.
+func (d *declarationOf[T]) CheckDecorateAll(dec interfaceDecorator) {
+	if dec, ok := d.interfaceDecorator(dec); ok {
+		if _, isConstructed := d.ConstructedValue(); isConstructed {
+			panic(lateDecoratorPanic(dec))
+		}
+	}
+}
 
-	for _, dep := range deps {
-		d.dependsOn(dep, dec)
+// interfaceDecorator returns a decorator of T that calls dec. ok is false if T
+// does not implement dec's interface.
+//
+// If T is a ByName or FromGroup type, the decorator is applied to the wrapped
+// value, and only if the type of the wrapped value implements the interface.
+func (d *declarationOf[T]) interfaceDecorator(dec interfaceDecorator) (decorator[T], bool) {
+	var zero T
+	_, isWrapper := any(zero).(wrapper)
+
+	t := d.ValueType()
+	if !t.Implements(dec.iface) {
+		return decorator[T]{}, false
+	}
+
+	return decorator[T]{
+		func(ctx Context, v T) (T, error) {
+			var value any = v
+			if isWrapper {
+				value = any(v).(wrapper).unwrap().Value
+			}
+
+			r, err := dec.impl(ctx, value)
+			if err != nil {
+				return v, err
+			}
+
+			if isWrapper {
+				if w, ok := any(v).(wrapper).rewrap(r); ok {
+					return w.(T), nil
+				}
+			} else if v, ok := r.(T); ok {
+				return v, nil
+			}
+
+			return v, fmt.Errorf(
+				"%s decorator returned %T, which is not a %s",
+				dec.iface,
+				r,
+				t,
+			)
+		},
+		dec.loc,
+		dec.priority,
+	}, true
+}
+
+// addDecorator adds a decorator that is called after T's constructor.
+func (d *declarationOf[T]) addDecorator(
+	dec decorator[T],
+	deps ...declaration,
+) {
+	// Validate the decorator in full before modifying the dependency graph,
+	// so that a rejected decorator leaves no trace when the panic is recovered
+	// by the CollectErrors() option.
+	if _, isConstructed := d.ConstructedValue(); isConstructed {
+		panic(lateDecoratorPanic(dec))
+	}
+
+	dependencies := d.checkDependencies(dec, 3, deps) // skip the context and value parameters
+
+	for _, dep := range dependencies {
+		d.addDependency(dep)
 	}
 
 	d.m.Lock()
 	defer d.m.Unlock()
 
-	if d.isConstructed {
-		panic(fmt.Sprintf(
-			"cannot add %s because the value has already been constructed",
-			dec,
-		))
+	if d.isConstructed.Load() {
+		panic(lateDecoratorPanic(dec))
+	}
+
+	// Insert the decorator after any existing decorators with the same or
+	// lower priority, keeping the decorators in the order they are applied.
+	i := sort.Search(
+		len(d.decorators),
+		func(i int) bool {
+			return d.decorators[i].priority > dec.priority
+		},
+	)
+
+	d.decorators = slices.Insert(d.decorators, i, dec)
+}
+
+// lateDecoratorPanic returns the panic value used when dec is added after the
+// value has already been constructed.
+func lateDecoratorPanic(dec userFunction) declarationPanic {
+	return declarationPanicf(
+		"cannot add %s because the value has already been constructed",
+		dec,
+	)
+}
+
+// checkDependencies returns the dependencies that scope introduces on deps,
+// panicking if any of them would introduce a cyclic dependency.
+//
+// offset is the position of scope's first parameter that refers to one of
+// deps, starting at 1.
+func (d *declarationOf[T]) checkDependencies(
+	scope userFunction,
+	offset int,
+	deps []declaration,
+) []dependency {
+	dependencies := make([]dependency, len(deps))
+
+	for i, dep := range deps {
+		dependencies[i] = dependency{dep, scope, i + offset}
+		d.checkDependency(dependencies[i])
 	}
 
-	d.decorators = append(d.decorators, dec)
+	return dependencies
 }
 
-// dependsOn adds a dependency on type t.
-func (d *declarationOf[T]) dependsOn(t declaration, scope userFunction) {
-	path := findPath(t, d)
+// checkDependency panics if adding dep would introduce a cyclic dependency.
+func (d *declarationOf[T]) checkDependency(dep dependency) {
+	path := findPath(dep.Declaration, d)
 
 	if len(path) == 1 {
-		panic(fmt.Sprintf(
-			"%s depends on itself",
-			scope,
+		panic(declarationPanicf(
+			"%s depends on itself via parameter %d",
+			dep.Scope,
+			dep.Param,
 		))
 	}
 
 	if len(path) != 0 {
 		message := fmt.Sprintf(
 			"%s introduces a cyclic dependency:",
-			scope,
+			dep.Scope,
 		)
 
+		via := dep
 		for i := len(path) - 1; i >= 0; i-- {
-			dep := path[i]
+			hop := path[i]
+
+			if i != len(path)-1 {
+				via, _ = path[i+1].DependencyOn(hop)
+			}
 
 			message += fmt.Sprintf(
-				"\n\t-> %s (%s)",
-				dep.Type(),
-				dep.BestLocation(),
+				"\n\t-> %s (%s), via %s",
+				hop.Type(),
+				hop.BestLocation(),
+				via,
 			)
 		}
 
-		panic(message)
+		panic(declarationPanic(message))
 	}
+}
+
+// addDependency adds a dependency on dep.Declaration.
+//
+// The dependency must already have been validated by checkDependency().
+func (d *declarationOf[T]) addDependency(dep dependency) {
+	t := dep.Declaration
 
 	d.m.Lock()
 	defer d.m.Unlock()
 
 	if d.deps == nil {
-		d.deps = map[reflect.Type]declaration{}
+		d.deps = map[reflect.Type]dependency{}
+	}
+
+	// Only the first function to introduce the dependency is recorded.
+	if _, ok := d.deps[t.Type()]; !ok {
+		d.deps[t.Type()] = dep
 	}
 
-	d.deps[t.Type()] = t
 	t.MarkAsDependency()
 }
 
@@ -222,7 +445,7 @@ func (d *declarationOf[T]) Resolve(ctx context.Context) (T, error) {
 	d.m.Lock()
 	defer d.m.Unlock()
 
-	if d.isConstructed {
+	if d.isConstructed.Load() {
 		return d.value, nil
 	}
 
@@ -230,29 +453,100 @@ func (d *declarationOf[T]) Resolve(ctx context.Context) (T, error) {
 		return d.value, undeclaredConstructorError{d}
 	}
 
-	var defers deferSet
-	defer defers.Call()
+	defers := &deferSet{}
+	defer defers.Call(d.observer)
 
-	var err error
-	d.value, err = d.constructor.Call(ctx, &defers)
+	v, err := d.constructWithRetry(ctx, defers)
 	if err != nil {
-		return d.value, err
+		return v, err
 	}
 
-	for _, dec := range d.decorators {
-		d.value, err = dec.Call(ctx, d.value, &defers)
+	defers.TransferOwnership(d.defers)
+
+	d.value = v
+	d.isConstructed.Store(true)
+
+	// Discard the functions, but retain their locations for use in
+	// diagnostics.
+	d.constructor.impl = nil
+	for i := range d.decorators {
+		d.decorators[i].impl = nil
+	}
+
+	return d.value, nil
+}
+
+// constructOnce makes a single attempt to construct a new value, applying the
+// timeout, if any.
+//
+// Any functions deferred by the constructor and decorators are added to defers.
+func (d *declarationOf[T]) constructOnce(
+	ctx context.Context,
+	defers *deferSet,
+) (T, error) {
+	if d.timeout > 0 {
+		return d.constructWithTimeout(ctx, defers)
+	}
+
+	return d.construct(ctx, d.constructor, d.decorators, defers)
+}
+
+// construct calls ctor and decorators to construct a new value.
+//
+// Any functions they defer are added to defers.
+func (d *declarationOf[T]) construct(
+	ctx context.Context,
+	ctor constructor[T],
+	decorators []decorator[T],
+	defers *deferSet,
+) (T, error) {
+	v, err := ctor.Call(ctx, d.observer, defers)
+	if err != nil {
+		return v, err
+	}
+
+	for _, dec := range decorators {
+		v, err = dec.Call(ctx, v, d.observer, defers)
 		if err != nil {
-			return d.value, err
+			return v, err
 		}
 	}
 
-	defers.TransferOwnership(d.defers)
+	return v, nil
+}
 
-	d.isConstructed = true
-	d.constructor = constructor[T]{}
-	d.decorators = nil
+// ResolveAny returns the value constructed by this declaration.
+func (d *declarationOf[T]) ResolveAny(ctx context.Context) (any, error) {
+	return d.Resolve(ctx)
+}
 
-	return d.value, nil
+// ConstructedValue returns the value constructed by this declaration, if it has
+// already been constructed.
+//
+// It does not lock d.m, so it does not block while the value is being
+// constructed.
+func (d *declarationOf[T]) ConstructedValue() (any, bool) {
+	if d.isConstructed.Load() {
+		// d.value is never modified once isConstructed is set.
+		return d.value, true
+	}
+
+	var zero T
+	return zero, false
+}
+
+// DecoratorLocations returns the locations of the declaration's decorators, in
+// the order they are applied.
+func (d *declarationOf[T]) DecoratorLocations() []location {
+	d.m.Lock()
+	defer d.m.Unlock()
+
+	var locations []location
+	for _, dec := range d.decorators {
+		locations = append(locations, dec.Location())
+	}
+
+	return locations
 }
 
 // Type returns the type of the value constructed by this declaration.
@@ -260,6 +554,15 @@ func (d *declarationOf[T]) Type() reflect.Type {
 	return typeOf[T]()
 }
 
+// ValueType returns the type of the dependency value.
+func (d *declarationOf[T]) ValueType() reflect.Type {
+	var zero T
+	if w, ok := any(zero).(wrapper); ok {
+		return w.unwrap().Type
+	}
+	return typeOf[T]()
+}
+
 // BestLocation returns the "best" known location of the declaration in code.
 //
 // Typically this is the location of the constructor for the definition, but it
@@ -288,13 +591,50 @@ func (d *declarationOf[T]) IsDependency() bool {
 	return d.isDep
 }
 
+// IsDeclared returns true if a constructor has been declared.
+func (d *declarationOf[T]) IsDeclared() bool {
+	d.m.Lock()
+	defer d.m.Unlock()
+
+	return d.isDeclared
+}
+
 // Dependencies returns the declarations that this declaration depends upon,
 // sorted by type.
 func (d *declarationOf[T]) Dependencies() []declaration {
 	d.m.Lock()
 	defer d.m.Unlock()
 
-	return sortDeclarations(d.deps)
+	declarations := map[reflect.Type]declaration{}
+	for t, dep := range d.deps {
+		declarations[t] = dep.Declaration
+	}
+
+	return sortDeclarations(declarations)
+}
+
+// DependencyOn returns the dependency through which this declaration (possibly
+// indirectly, via an implicit declaration) depends upon t.
+func (d *declarationOf[T]) DependencyOn(t declaration) (dependency, bool) {
+	d.m.Lock()
+	dep, ok := d.deps[t.Type()]
+	d.m.Unlock()
+
+	if ok {
+		return dep, true
+	}
+
+	for _, decl := range d.Dependencies() {
+		if decl.IsImplicit() && len(findPath(decl, t)) != 0 {
+			d.m.Lock()
+			dep := d.deps[decl.Type()]
+			d.m.Unlock()
+
+			return dep, true
+		}
+	}
+
+	return dependency{}, false
 }
 
 // IsImplicit returns true if this is an implicit declaration.
@@ -308,6 +648,15 @@ func (d *declarationOf[T]) IsImplicit() bool {
 	return d.isSelfDeclaring
 }
 
+// IsEager returns true if the value should be constructed when the container is
+// built.
+func (d *declarationOf[T]) IsEager() bool {
+	d.m.Lock()
+	defer d.m.Unlock()
+
+	return d.isEager
+}
+
 // MarkAsDependency marks the declaration as a dependency. That is, other
 // declarations depend upon this one.
 func (d *declarationOf[T]) MarkAsDependency() {
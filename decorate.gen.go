@@ -20,6 +20,7 @@ func Decorate0[T any](
 			func(ctx Context, v T) (T, error) {
 				return dec(ctx, v)
 			},
+			newDecorateOptions(options),
 		)
 	})
 }
@@ -49,6 +50,7 @@ func Decorate1[T, D any](
 
 				return dec(ctx, v, v1)
 			},
+			newDecorateOptions(options),
 			d1,
 		)
 	})
@@ -85,6 +87,7 @@ func Decorate2[T, D1, D2 any](
 
 				return dec(ctx, v, v1, v2)
 			},
+			newDecorateOptions(options),
 			d1,
 			d2,
 		)
@@ -128,6 +131,7 @@ func Decorate3[T, D1, D2, D3 any](
 
 				return dec(ctx, v, v1, v2, v3)
 			},
+			newDecorateOptions(options),
 			d1,
 			d2,
 			d3,
@@ -178,6 +182,7 @@ func Decorate4[T, D1, D2, D3, D4 any](
 
 				return dec(ctx, v, v1, v2, v3, v4)
 			},
+			newDecorateOptions(options),
 			d1,
 			d2,
 			d3,
@@ -235,6 +240,7 @@ func Decorate5[T, D1, D2, D3, D4, D5 any](
 
 				return dec(ctx, v, v1, v2, v3, v4, v5)
 			},
+			newDecorateOptions(options),
 			d1,
 			d2,
 			d3,
@@ -299,6 +305,7 @@ func Decorate6[T, D1, D2, D3, D4, D5, D6 any](
 
 				return dec(ctx, v, v1, v2, v3, v4, v5, v6)
 			},
+			newDecorateOptions(options),
 			d1,
 			d2,
 			d3,
@@ -370,6 +377,7 @@ func Decorate7[T, D1, D2, D3, D4, D5, D6, D7 any](
 
 				return dec(ctx, v, v1, v2, v3, v4, v5, v6, v7)
 			},
+			newDecorateOptions(options),
 			d1,
 			d2,
 			d3,
@@ -448,6 +456,7 @@ func Decorate8[T, D1, D2, D3, D4, D5, D6, D7, D8 any](
 
 				return dec(ctx, v, v1, v2, v3, v4, v5, v6, v7, v8)
 			},
+			newDecorateOptions(options),
 			d1,
 			d2,
 			d3,
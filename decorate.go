@@ -3,12 +3,48 @@ package imbue
 import (
 	"context"
 	"fmt"
+	"reflect"
+	"time"
 )
 
 // DecorateOption is an option that changes the behavior of a call to
 // DecorateX().
 type DecorateOption interface {
-	applyDecorateOption()
+	applyDecorateOption(*decorateOptions)
+}
+
+// decorateOptions is the set of options that apply to a single call to
+// DecorateX().
+type decorateOptions struct {
+	// priority determines the order in which decorators are applied.
+	priority int
+}
+
+// newDecorateOptions returns the decorateOptions described by the given
+// options.
+func newDecorateOptions(options []DecorateOption) decorateOptions {
+	var opts decorateOptions
+
+	for _, opt := range options {
+		opt.applyDecorateOption(&opts)
+	}
+
+	return opts
+}
+
+// Priority is a DecorateOption that controls the order in which decorators of
+// the same type are applied.
+//
+// Decorators with lower priority values are applied before those with higher
+// values, such that the decorator with the highest priority value is given the
+// final say over the decorated value. Decorators with the same priority are
+// applied in the order they are declared. The default priority is zero.
+func Priority(p int) DecorateOption {
+	return option{
+		forDecorate: func(opts *decorateOptions) {
+			opts.priority = p
+		},
+	}
 }
 
 // decorator is a wrapper around a function that decorates a value of type T.
@@ -22,18 +58,35 @@ type decorator[T any] struct {
 
 	// loc is the location of the code that provided the decorator.
 	loc location
+
+	// priority determines the order in which the decorator is applied relative
+	// to other decorators of the same type.
+	priority int
 }
 
 // Call returns the decorated version of v.
-func (d decorator[T]) Call(ctx context.Context, v T, defers *deferSet) (T, error) {
+func (d decorator[T]) Call(
+	ctx context.Context,
+	v T,
+	obs Observer,
+	defers *deferSet,
+) (T, error) {
+	info := typeInfoOf(d)
+	ctx = obs.DecorateStart(ctx, info)
+	start := time.Now()
+
 	v, err := d.impl(
 		&scopedContext{
-			Context: ctx,
-			scope:   d,
-			defers:  defers,
+			Context:  ctx,
+			scope:    d,
+			observer: obs,
+			defers:   defers,
 		},
 		v,
 	)
+
+	obs.DecorateEnd(ctx, info, err, time.Since(start))
+
 	if err != nil {
 		return v, fmt.Errorf(
 			"%s failed: %w",
@@ -45,6 +98,11 @@ func (d decorator[T]) Call(ctx context.Context, v T, defers *deferSet) (T, error
 	return v, nil
 }
 
+// Type returns the type of the value decorated by the decorator.
+func (d decorator[T]) Type() reflect.Type {
+	return typeOf[T]()
+}
+
 // Location returns the location of the code that provided the decorator.
 //
 // This is typically the location of the call to the DecorateX() function, not
@@ -54,7 +112,19 @@ func (d decorator[T]) Location() location {
 }
 
 // String returns a description of the decorator for use in error messages.
+//
+// The priority is included if it is non-zero, as it affects the order in which
+// the decorator is applied.
 func (d decorator[T]) String() string {
+	if d.priority != 0 {
+		return fmt.Sprintf(
+			"%s decorator (%s, priority %d)",
+			typeOf[T](),
+			d.loc,
+			d.priority,
+		)
+	}
+
 	return fmt.Sprintf(
 		"%s decorator (%s)",
 		typeOf[T](),
@@ -2,6 +2,7 @@ package imbue_test
 
 import (
 	"context"
+	"errors"
 
 	"github.com/dogmatiq/imbue"
 	. "github.com/onsi/ginkgo/v2"
@@ -257,6 +258,107 @@ var _ = Describe("func DecorateX()", func() {
 		)
 	})
 
+	It("applies decorators in order of priority", func() {
+		imbue.With0(
+			container,
+			func(ctx imbue.Context) (Concrete1, error) {
+				return "<concrete>", nil
+			},
+		)
+
+		imbue.Decorate0(
+			container,
+			func(
+				ctx imbue.Context,
+				v Concrete1,
+			) (Concrete1, error) {
+				return v + "+<outer>", nil
+			},
+			imbue.Priority(10),
+		)
+
+		imbue.Decorate0(
+			container,
+			func(
+				ctx imbue.Context,
+				v Concrete1,
+			) (Concrete1, error) {
+				return v + "+<inner-1>", nil
+			},
+		)
+
+		imbue.Decorate0(
+			container,
+			func(
+				ctx imbue.Context,
+				v Concrete1,
+			) (Concrete1, error) {
+				return v + "+<innermost>", nil
+			},
+			imbue.Priority(-10),
+		)
+
+		imbue.Decorate0(
+			container,
+			func(
+				ctx imbue.Context,
+				v Concrete1,
+			) (Concrete1, error) {
+				return v + "+<inner-2>", nil
+			},
+		)
+
+		imbue.Invoke1(
+			context.Background(),
+			container,
+			func(
+				ctx context.Context,
+				dep Concrete1,
+			) error {
+				Expect(dep).To(Equal(Concrete1("<concrete>+<innermost>+<inner-1>+<inner-2>+<outer>")))
+				return nil
+			},
+		)
+	})
+
+	It("includes the priority in error messages", func() {
+		imbue.With0(
+			container,
+			func(ctx imbue.Context) (Concrete1, error) {
+				return "<concrete>", nil
+			},
+		)
+
+		imbue.Decorate0(
+			container,
+			func(
+				ctx imbue.Context,
+				v Concrete1,
+			) (Concrete1, error) {
+				return "", errors.New("<error>")
+			},
+			imbue.Priority(10),
+		)
+
+		err := imbue.Invoke1(
+			context.Background(),
+			container,
+			func(
+				ctx context.Context,
+				dep Concrete1,
+			) error {
+				panic("unexpected call")
+			},
+		)
+		Expect(err).To(
+			MatchError(
+				MatchRegexp(
+					`imbue_test\.Concrete1 decorator \(decorate_test\.go:\d+, priority 10\) failed: <error>`,
+				),
+			),
+		)
+	})
+
 	It("panics when a decorator is declared after the constructor has been called", func() {
 		imbue.With0(
 			container,
@@ -310,7 +412,7 @@ var _ = Describe("func DecorateX()", func() {
 		}).To(
 			PanicWith(
 				MatchRegexp(
-					`imbue_test\.Concrete1 decorator \(decorate_test\.go:\d+\) depends on itself`,
+					`imbue_test\.Concrete1 decorator \(decorate_test\.go:\d+\) depends on itself via parameter 3$`,
 				),
 			),
 		)
@@ -352,9 +454,9 @@ var _ = Describe("func DecorateX()", func() {
 			PanicWith(
 				MatchRegexp(
 					`imbue_test\.Concrete3 decorator \(decorate_test\.go:\d+\) introduces a cyclic dependency:` +
-						`\n\t-> imbue_test\.Concrete2 \(decorate_test\.go:\d+\)` +
-						`\n\t-> imbue_test\.Concrete1 \(decorate_test\.go:\d+\)` +
-						`\n\t-> imbue_test\.Concrete3 \(decorate_test\.go:\d+\)`,
+						`\n\t-> imbue_test\.Concrete2 \(decorate_test\.go:\d+\), via parameter 3 \(imbue_test\.Concrete2\) of imbue_test\.Concrete3 decorator \(decorate_test\.go:\d+\)` +
+						`\n\t-> imbue_test\.Concrete1 \(decorate_test\.go:\d+\), via parameter 2 \(imbue_test\.Concrete1\) of imbue_test\.Concrete2 constructor \(decorate_test\.go:\d+\)` +
+						`\n\t-> imbue_test\.Concrete3 \(decorate_test\.go:\d+\), via parameter 2 \(imbue_test\.Concrete3\) of imbue_test\.Concrete1 constructor \(decorate_test\.go:\d+\)$`,
 				),
 			),
 		)
@@ -0,0 +1,92 @@
+package imbue
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// DecorateAll describes how to decorate values of every type that implements
+// the interface I after construction.
+//
+// The decorator applies to all types within the container that implement I,
+// regardless of whether they are declared before or after the call to
+// DecorateAll(). Named and grouped dependencies are decorated if the type of
+// the named or grouped value implements I.
+//
+// The dependency being decorated is passed to dec and replaced with the
+// decorator's return value, which must be of the same type as the dependency
+// being decorated.
+//
+// The decorated dependency may be manipulated in-place.
+func DecorateAll[I any](
+	con ContainerAware,
+	dec func(Context, I) (I, error),
+	options ...DecorateOption,
+) {
+	iface := typeOf[I]()
+	if iface.Kind() != reflect.Interface {
+		panic(fmt.Sprintf(
+			"cannot decorate all implementations of %s because it is not an interface",
+			iface,
+		))
+	}
+
+	d := interfaceDecorator{
+		func(ctx Context, v any) (any, error) {
+			i, _ := v.(I) // v may be a nil interface
+			return dec(ctx, i)
+		},
+		iface,
+		findLocation(),
+		newDecorateOptions(options).priority,
+	}
+
+	con.withContainer(func(con *Container) {
+		for _, decl := range con.addDecorateAll(d) {
+			decl.DecorateAll(d)
+		}
+	})
+}
+
+// addDecorateAll adds dec to the decorators that are applied to declarations
+// as they are added to the container.
+//
+// It returns the existing declarations, to which the caller must apply dec.
+// Declarations added after this method returns have dec applied by get().
+func (c *Container) addDecorateAll(dec interfaceDecorator) []declaration {
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	declarations := sortDeclarations(c.declarations)
+
+	// Check every declaration before decorating any of them, so that a
+	// rejected decorator is not applied to only some of the declarations.
+	//
+	// CheckDecorateAll() does not lock the declaration, so it is safe to call
+	// while c.m is locked.
+	for _, decl := range declarations {
+		decl.CheckDecorateAll(dec)
+	}
+
+	c.decorateAll = append(c.decorateAll, dec)
+
+	return declarations
+}
+
+// interfaceDecorator is a decorator that applies to all types that implement a
+// specific interface.
+type interfaceDecorator struct {
+	// impl is the decorator implementation. It is a closure generated by
+	// DecorateAll() that wraps the user-provided decorator function.
+	impl func(Context, any) (any, error)
+
+	// iface is the interface type that a type must implement to be decorated.
+	iface reflect.Type
+
+	// loc is the location of the code that provided the decorator.
+	loc location
+
+	// priority determines the order in which the decorator is applied relative
+	// to other decorators of the same type.
+	priority int
+}
@@ -0,0 +1,314 @@
+package imbue_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/dogmatiq/imbue"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// labeler is an interface used to test DecorateAll().
+type labeler interface {
+	Label() string
+}
+
+type (
+	Labeled1 struct{ label string }
+	Labeled2 struct{ label string }
+
+	// Primary is a name for a *Labeled1.
+	Primary imbue.Name[*Labeled1]
+)
+
+func (l *Labeled1) Label() string { return l.label }
+func (l *Labeled2) Label() string { return l.label }
+
+var _ = Describe("func DecorateAll()", func() {
+	var container *imbue.Container
+
+	BeforeEach(func() {
+		container = imbue.New()
+	})
+
+	AfterEach(func() {
+		container.Close()
+	})
+
+	It("decorates every type that implements the interface", func() {
+		imbue.With0(
+			container,
+			func(ctx imbue.Context) (*Labeled1, error) {
+				return &Labeled1{"<label-1>"}, nil
+			},
+		)
+
+		var decorated []string
+		imbue.DecorateAll(
+			container,
+			func(
+				ctx imbue.Context,
+				v labeler,
+			) (labeler, error) {
+				decorated = append(decorated, v.Label())
+				return v, nil
+			},
+		)
+
+		imbue.With0(
+			container,
+			func(ctx imbue.Context) (*Labeled2, error) {
+				return &Labeled2{"<label-2>"}, nil
+			},
+		)
+
+		imbue.With0(
+			container,
+			func(ctx imbue.Context) (Concrete1, error) {
+				return "<concrete>", nil
+			},
+		)
+
+		err := imbue.Invoke3(
+			context.Background(),
+			container,
+			func(
+				ctx context.Context,
+				dep1 *Labeled1,
+				dep2 *Labeled2,
+				dep3 Concrete1,
+			) error {
+				return nil
+			},
+		)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(decorated).To(ConsistOf("<label-1>", "<label-2>"))
+	})
+
+	It("decorates named dependencies", func() {
+		imbue.With0Named[Primary](
+			container,
+			func(ctx imbue.Context) (*Labeled1, error) {
+				return &Labeled1{"<label>"}, nil
+			},
+		)
+
+		imbue.DecorateAll(
+			container,
+			func(
+				ctx imbue.Context,
+				v labeler,
+			) (labeler, error) {
+				return &Labeled1{v.Label() + "<decorated>"}, nil
+			},
+		)
+
+		err := imbue.Invoke1(
+			context.Background(),
+			container,
+			func(
+				ctx context.Context,
+				dep imbue.ByName[Primary, *Labeled1],
+			) error {
+				Expect(dep.Value().Label()).To(Equal("<label><decorated>"))
+				return nil
+			},
+		)
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+
+	It("decorates grouped dependencies", func() {
+		imbue.With0Grouped[ServiceA](
+			container,
+			func(ctx imbue.Context) (*Labeled1, error) {
+				return &Labeled1{"<label>"}, nil
+			},
+		)
+
+		imbue.DecorateAll(
+			container,
+			func(
+				ctx imbue.Context,
+				v labeler,
+			) (labeler, error) {
+				return &Labeled1{v.Label() + "<decorated>"}, nil
+			},
+		)
+
+		err := imbue.Invoke1(
+			context.Background(),
+			container,
+			func(
+				ctx context.Context,
+				dep imbue.FromGroup[ServiceA, *Labeled1],
+			) error {
+				Expect(dep.Value().Label()).To(Equal("<label><decorated>"))
+				return nil
+			},
+		)
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+
+	It("returns an error if the decorator returns a value of a different type to a named dependency", func() {
+		imbue.With0Named[Primary](
+			container,
+			func(ctx imbue.Context) (*Labeled1, error) {
+				return &Labeled1{"<label>"}, nil
+			},
+		)
+
+		imbue.DecorateAll(
+			container,
+			func(
+				ctx imbue.Context,
+				v labeler,
+			) (labeler, error) {
+				return &Labeled2{}, nil
+			},
+		)
+
+		_, err := imbue.Get[imbue.ByName[Primary, *Labeled1]](context.Background(), container)
+		Expect(err).To(MatchError(MatchRegexp(
+			`imbue_test\.labeler decorator returned \*imbue_test\.Labeled2, which is not a \*imbue_test\.Labeled1$`,
+		)))
+	})
+
+	It("applies decorators in order of priority", func() {
+		imbue.With0(
+			container,
+			func(ctx imbue.Context) (*Labeled1, error) {
+				return &Labeled1{"<label>"}, nil
+			},
+		)
+
+		imbue.Decorate0(
+			container,
+			func(
+				ctx imbue.Context,
+				v *Labeled1,
+			) (*Labeled1, error) {
+				v.label += "+<type>"
+				return v, nil
+			},
+		)
+
+		imbue.DecorateAll(
+			container,
+			func(
+				ctx imbue.Context,
+				v labeler,
+			) (labeler, error) {
+				l := v.(*Labeled1)
+				l.label += "+<interface>"
+				return l, nil
+			},
+			imbue.Priority(-1),
+		)
+
+		err := imbue.Invoke1(
+			context.Background(),
+			container,
+			func(
+				ctx context.Context,
+				dep *Labeled1,
+			) error {
+				Expect(dep.Label()).To(Equal("<label>+<interface>+<type>"))
+				return nil
+			},
+		)
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+
+	It("returns an error if the decorator returns a value of a different type", func() {
+		imbue.With0(
+			container,
+			func(ctx imbue.Context) (*Labeled1, error) {
+				return &Labeled1{"<label>"}, nil
+			},
+		)
+
+		imbue.DecorateAll(
+			container,
+			func(
+				ctx imbue.Context,
+				v labeler,
+			) (labeler, error) {
+				return &Labeled2{}, nil
+			},
+		)
+
+		err := imbue.Invoke1(
+			context.Background(),
+			container,
+			func(
+				ctx context.Context,
+				dep *Labeled1,
+			) error {
+				panic("unexpected call")
+			},
+		)
+		Expect(err).To(
+			MatchError(
+				MatchRegexp(
+					`\*imbue_test\.Labeled1 decorator \(decorateall_test\.go:\d+\) failed: imbue_test\.labeler decorator returned \*imbue_test\.Labeled2, which is not a \*imbue_test\.Labeled1`,
+				),
+			),
+		)
+	})
+
+	It("returns an error if the decorator fails", func() {
+		imbue.With0(
+			container,
+			func(ctx imbue.Context) (*Labeled1, error) {
+				return &Labeled1{"<label>"}, nil
+			},
+		)
+
+		imbue.DecorateAll(
+			container,
+			func(
+				ctx imbue.Context,
+				v labeler,
+			) (labeler, error) {
+				return v, errors.New("<error>")
+			},
+		)
+
+		err := imbue.Invoke1(
+			context.Background(),
+			container,
+			func(
+				ctx context.Context,
+				dep *Labeled1,
+			) error {
+				panic("unexpected call")
+			},
+		)
+		Expect(err).To(
+			MatchError(
+				MatchRegexp(
+					`\*imbue_test\.Labeled1 decorator \(decorateall_test\.go:\d+\) failed: <error>`,
+				),
+			),
+		)
+	})
+
+	It("panics if I is not an interface", func() {
+		Expect(func() {
+			imbue.DecorateAll(
+				container,
+				func(
+					ctx imbue.Context,
+					v Concrete1,
+				) (Concrete1, error) {
+					panic("unexpected call")
+				},
+			)
+		}).To(
+			PanicWith(
+				`cannot decorate all implementations of imbue_test.Concrete1 because it is not an interface`,
+			),
+		)
+	})
+})
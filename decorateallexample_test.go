@@ -0,0 +1,67 @@
+package imbue_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/dogmatiq/imbue"
+)
+
+// Validator is an interface for configuration types that can validate
+// themselves.
+type Validator interface {
+	Validate() error
+}
+
+// ServerConfig is a configuration type that implements Validator.
+type ServerConfig struct {
+	Port int
+}
+
+// Validate returns an error if the configuration is invalid.
+func (c ServerConfig) Validate() error {
+	if c.Port == 0 {
+		return errors.New("port must not be zero")
+	}
+	return nil
+}
+
+func ExampleDecorateAll() {
+	con := imbue.New()
+	defer con.Close()
+
+	// Validate every dependency that implements the Validator interface.
+	imbue.DecorateAll(
+		con,
+		func(
+			ctx imbue.Context,
+			v Validator,
+		) (Validator, error) {
+			return v, v.Validate()
+		},
+	)
+
+	// Declare a constructor for the ServerConfig type.
+	imbue.With0(
+		con,
+		func(ctx imbue.Context) (ServerConfig, error) {
+			return ServerConfig{}, nil
+		},
+	)
+
+	err := imbue.Invoke1(
+		context.Background(),
+		con,
+		func(
+			ctx context.Context,
+			cfg ServerConfig,
+		) error {
+			return nil
+		},
+	)
+	fmt.Println(errors.Unwrap(err))
+
+	// Output:
+	// port must not be zero
+}
@@ -2,6 +2,7 @@ package imbue
 
 import (
 	"fmt"
+	"reflect"
 	"sync"
 )
 
@@ -19,7 +20,7 @@ func (s *deferSet) Add(d deferred) {
 }
 
 // Call invokes the deferred functions in reverse order.
-func (s *deferSet) Call() (errors []error) {
+func (s *deferSet) Call(obs Observer) (errors []error) {
 	s.m.Lock()
 	defers := s.defers
 	s.defers = nil
@@ -32,7 +33,7 @@ func (s *deferSet) Call() (errors []error) {
 		// guaranteeing that the functions are invoked in reverse order _and_
 		// that they are always invoked, even if one of them panics.
 		defer func() {
-			if err := e.Call(); err != nil {
+			if err := e.Call(obs); err != nil {
 				errors = append(errors, err)
 			}
 		}()
@@ -69,8 +70,11 @@ type deferred struct {
 }
 
 // Call invokes the deferred function.
-func (d deferred) Call() error {
-	if err := d.impl(); err != nil {
+func (d deferred) Call(obs Observer) error {
+	err := d.impl()
+	obs.DeferCalled(typeInfoOf(d), err)
+
+	if err != nil {
 		return fmt.Errorf(
 			"%s failed: %w",
 			d,
@@ -81,6 +85,12 @@ func (d deferred) Call() error {
 	return nil
 }
 
+// Type returns the type of the value that was being constructed or decorated
+// when the function was deferred.
+func (d deferred) Type() reflect.Type {
+	return d.scope.Type()
+}
+
 // Location returns the location of the code that deferred the function.
 //
 // This is typically the location of the call to the Context.Defer() method, not
@@ -0,0 +1,168 @@
+package imbue
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// Explain returns a human-readable description of why T is needed by the
+// container.
+//
+// It lists every path to T from a root declaration (one that no other
+// declaration depends upon) and from each call to InvokeX() or GoX(), along
+// with the location of each declaration along the path.
+//
+// At most 20 paths are listed, followed by the number of paths that were
+// omitted.
+func Explain[T any](con *Container) string {
+	t := typeOf[T]()
+
+	con.m.Lock()
+	target, ok := con.declarations[t]
+	declarations := sortDeclarations(con.declarations)
+	invocations := sortInvocations(con.invocations)
+	con.m.Unlock()
+
+	if !ok {
+		return fmt.Sprintf("%s is not declared", t)
+	}
+
+	var (
+		finder = pathFinder{target, map[declaration]int{}}
+		lines  [][]string
+		total  int
+	)
+
+	for _, d := range declarations {
+		if d != target && !d.IsDependency() {
+			total = addPathCounts(total, finder.Count(d))
+
+			for _, p := range finder.Find(d, maxExplainPaths-len(lines)) {
+				lines = append(lines, describePath(p))
+			}
+		}
+	}
+
+	for _, inv := range invocations {
+		for _, dep := range inv.deps {
+			total = addPathCounts(total, finder.Count(dep))
+
+			for _, p := range finder.Find(dep, maxExplainPaths-len(lines)) {
+				lines = append(
+					lines,
+					append([]string{inv.String()}, describePath(p)...),
+				)
+			}
+		}
+	}
+
+	if total == 0 {
+		return fmt.Sprintf("%s is not a dependency of any other declaration", t)
+	}
+
+	var w strings.Builder
+	fmt.Fprintf(&w, "%d path(s) lead to %s:", total, t)
+
+	for i, hops := range lines {
+		for j, hop := range hops {
+			if j == 0 {
+				fmt.Fprintf(&w, "\n\t%d) %s", i+1, hop)
+			} else {
+				fmt.Fprintf(&w, "\n\t\t-> %s", hop)
+			}
+		}
+	}
+
+	if n := total - len(lines); n > 0 {
+		fmt.Fprintf(&w, "\n\t... and %d more", n)
+	}
+
+	return w.String()
+}
+
+// maxExplainPaths is the maximum number of paths listed by Explain().
+const maxExplainPaths = 20
+
+// pathFinder finds the paths that lead from declarations to a specific target
+// declaration.
+//
+// The number of paths can grow exponentially with the depth of the dependency
+// graph, particularly for widely-shared dependencies, so the paths are never
+// enumerated in full. Instead, the number of paths from each declaration is
+// memoised, which allows Find() to skip dependencies that do not lead to the
+// target.
+type pathFinder struct {
+	target declaration
+	counts map[declaration]int
+}
+
+// Count returns the number of paths from d to the target.
+func (f pathFinder) Count(d declaration) int {
+	if d == f.target {
+		return 1
+	}
+
+	if n, ok := f.counts[d]; ok {
+		return n
+	}
+
+	n := 0
+	for _, dep := range d.Dependencies() {
+		n = addPathCounts(n, f.Count(dep))
+	}
+
+	f.counts[d] = n
+	return n
+}
+
+// Find returns up to limit paths from d to the target.
+//
+// Each path is in the same form as those returned by findPath().
+func (f pathFinder) Find(d declaration, limit int) [][]declaration {
+	if limit <= 0 || f.Count(d) == 0 {
+		return nil
+	}
+
+	if d == f.target {
+		return [][]declaration{{d}}
+	}
+
+	var paths [][]declaration
+
+	for _, dep := range d.Dependencies() {
+		for _, p := range f.Find(dep, limit-len(paths)) {
+			if !d.IsImplicit() {
+				p = append(p, d)
+			}
+
+			paths = append(paths, p)
+		}
+	}
+
+	return paths
+}
+
+// addPathCounts returns a + b, saturating at math.MaxInt rather than
+// overflowing.
+func addPathCounts(a, b int) int {
+	if a > math.MaxInt-b {
+		return math.MaxInt
+	}
+	return a + b
+}
+
+// describePath returns a description of each declaration in p, a path as
+// returned by pathFinder.Find(), starting with the dependent declaration.
+func describePath(p []declaration) []string {
+	hops := make([]string, 0, len(p))
+
+	for i := len(p) - 1; i >= 0; i-- {
+		hops = append(
+			hops,
+			fmt.Sprintf("%s (%s)", p[i].Type(), p[i].BestLocation()),
+		)
+	}
+
+	return hops
+}
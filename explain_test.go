@@ -0,0 +1,230 @@
+package imbue_test
+
+import (
+	"context"
+
+	"github.com/dogmatiq/imbue"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// Layer<N><A|B> are types used to build a dependency graph in which the number
+// of paths doubles with each layer.
+type (
+	Layer1A string
+	Layer1B string
+	Layer2A string
+	Layer2B string
+	Layer3A string
+	Layer3B string
+	Layer4A string
+	Layer4B string
+	Layer5A string
+	Layer5B string
+)
+
+var _ = Describe("func Explain()", func() {
+	var container *imbue.Container
+
+	BeforeEach(func() {
+		container = imbue.New()
+	})
+
+	AfterEach(func() {
+		container.Close()
+	})
+
+	It("lists every path from a root declaration to the type", func() {
+		imbue.With0(
+			container,
+			func(ctx imbue.Context) (Concrete1, error) {
+				return "<concrete>", nil
+			},
+		)
+
+		imbue.With1(
+			container,
+			func(ctx imbue.Context, dep Concrete1) (Concrete2, error) {
+				return "<concrete>", nil
+			},
+		)
+
+		imbue.With2(
+			container,
+			func(ctx imbue.Context, dep1 Concrete1, dep2 Concrete2) (Concrete3, error) {
+				return "<concrete>", nil
+			},
+		)
+
+		Expect(imbue.Explain[Concrete1](container)).To(MatchRegexp(
+			`^2 path\(s\) lead to imbue_test\.Concrete1:` +
+				`\n\t1\) imbue_test\.Concrete3 \(explain_test\.go:\d+\)` +
+				`\n\t\t-> imbue_test\.Concrete1 \(explain_test\.go:\d+\)` +
+				`\n\t2\) imbue_test\.Concrete3 \(explain_test\.go:\d+\)` +
+				`\n\t\t-> imbue_test\.Concrete2 \(explain_test\.go:\d+\)` +
+				`\n\t\t-> imbue_test\.Concrete1 \(explain_test\.go:\d+\)$`,
+		))
+	})
+
+	It("lists paths from calls to InvokeX()", func() {
+		imbue.With0(
+			container,
+			func(ctx imbue.Context) (Concrete1, error) {
+				return "<concrete>", nil
+			},
+		)
+
+		imbue.With1(
+			container,
+			func(ctx imbue.Context, dep Concrete1) (Concrete2, error) {
+				return "<concrete>", nil
+			},
+		)
+
+		err := imbue.Invoke2(
+			context.Background(),
+			container,
+			func(ctx context.Context, dep1 Concrete1, dep2 Concrete2) error {
+				return nil
+			},
+		)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		Expect(imbue.Explain[Concrete1](container)).To(MatchRegexp(
+			`^3 path\(s\) lead to imbue_test\.Concrete1:` +
+				`\n\t1\) imbue_test\.Concrete2 \(explain_test\.go:\d+\)` +
+				`\n\t\t-> imbue_test\.Concrete1 \(explain_test\.go:\d+\)` +
+				`\n\t2\) <invoke> \(explain_test\.go:\d+\)` +
+				`\n\t\t-> imbue_test\.Concrete1 \(explain_test\.go:\d+\)` +
+				`\n\t3\) <invoke> \(explain_test\.go:\d+\)` +
+				`\n\t\t-> imbue_test\.Concrete2 \(explain_test\.go:\d+\)` +
+				`\n\t\t-> imbue_test\.Concrete1 \(explain_test\.go:\d+\)$`,
+		))
+	})
+
+	It("omits implicit declarations from the path", func() {
+		imbue.With1(
+			container,
+			func(ctx imbue.Context, dep imbue.Optional[Concrete1]) (Concrete2, error) {
+				return "<concrete>", nil
+			},
+		)
+
+		Expect(imbue.Explain[Concrete1](container)).To(MatchRegexp(
+			`^1 path\(s\) lead to imbue_test\.Concrete1:` +
+				`\n\t1\) imbue_test\.Concrete2 \(explain_test\.go:\d+\)` +
+				`\n\t\t-> imbue_test\.Concrete1 \(explain_test\.go:\d+\)$`,
+		))
+	})
+
+	It("explains when the type is not a dependency", func() {
+		imbue.With0(
+			container,
+			func(ctx imbue.Context) (Concrete1, error) {
+				return "<concrete>", nil
+			},
+		)
+
+		Expect(imbue.Explain[Concrete1](container)).To(Equal(
+			"imbue_test.Concrete1 is not a dependency of any other declaration",
+		))
+	})
+
+	It("explains when the type is not declared", func() {
+		Expect(imbue.Explain[Concrete1](container)).To(Equal(
+			"imbue_test.Concrete1 is not declared",
+		))
+	})
+
+	It("limits the number of paths that are listed", func() {
+		imbue.With0(
+			container,
+			func(ctx imbue.Context) (Concrete1, error) {
+				return "<concrete>", nil
+			},
+		)
+
+		imbue.With1(
+			container,
+			func(ctx imbue.Context, _ Concrete1) (Layer1A, error) {
+				return "<concrete>", nil
+			},
+		)
+
+		imbue.With1(
+			container,
+			func(ctx imbue.Context, _ Concrete1) (Layer1B, error) {
+				return "<concrete>", nil
+			},
+		)
+
+		imbue.With2(
+			container,
+			func(ctx imbue.Context, _ Layer1A, _ Layer1B) (Layer2A, error) {
+				return "<concrete>", nil
+			},
+		)
+
+		imbue.With2(
+			container,
+			func(ctx imbue.Context, _ Layer1A, _ Layer1B) (Layer2B, error) {
+				return "<concrete>", nil
+			},
+		)
+
+		imbue.With2(
+			container,
+			func(ctx imbue.Context, _ Layer2A, _ Layer2B) (Layer3A, error) {
+				return "<concrete>", nil
+			},
+		)
+
+		imbue.With2(
+			container,
+			func(ctx imbue.Context, _ Layer2A, _ Layer2B) (Layer3B, error) {
+				return "<concrete>", nil
+			},
+		)
+
+		imbue.With2(
+			container,
+			func(ctx imbue.Context, _ Layer3A, _ Layer3B) (Layer4A, error) {
+				return "<concrete>", nil
+			},
+		)
+
+		imbue.With2(
+			container,
+			func(ctx imbue.Context, _ Layer3A, _ Layer3B) (Layer4B, error) {
+				return "<concrete>", nil
+			},
+		)
+
+		imbue.With2(
+			container,
+			func(ctx imbue.Context, _ Layer4A, _ Layer4B) (Layer5A, error) {
+				return "<concrete>", nil
+			},
+		)
+
+		imbue.With2(
+			container,
+			func(ctx imbue.Context, _ Layer4A, _ Layer4B) (Layer5B, error) {
+				return "<concrete>", nil
+			},
+		)
+
+		imbue.With2(
+			container,
+			func(ctx imbue.Context, _ Layer5A, _ Layer5B) (Concrete2, error) {
+				return "<concrete>", nil
+			},
+		)
+
+		explanation := imbue.Explain[Concrete1](container)
+		Expect(explanation).To(HavePrefix("32 path(s) lead to imbue_test.Concrete1:\n\t1) imbue_test.Concrete2 "))
+		Expect(explanation).To(ContainSubstring("\n\t20) "))
+		Expect(explanation).NotTo(ContainSubstring("\n\t21) "))
+		Expect(explanation).To(HaveSuffix("\n\t... and 12 more"))
+	})
+})
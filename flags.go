@@ -0,0 +1,174 @@
+package imbue
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/dogmatiq/imbue/internal/identifier"
+	"github.com/dogmatiq/imbue/internal/parse"
+)
+
+// WithFlags declares a constructor for the configuration struct T that
+// populates its fields from command-line flags.
+//
+// Each exported field of T is registered as a flag on fs immediately. The flag
+// name is the field name converted to kebab-case; for example, a field named
+// ListenAddress is populated by the -listen-address flag.
+//
+// The following struct tags change how a field is registered:
+//
+//   - flag:"name" uses name instead of the name derived from the field name,
+//     or flag:"-" to ignore the field entirely
+//   - default:"value" sets the default value of the flag
+//   - usage:"text" sets the flag's usage text
+//   - required:"true" causes construction to fail if the flag is not provided
+//
+// Fields that are structs themselves are registered recursively, using the
+// field's flag name as a prefix. Embedded structs do not add a prefix.
+//
+// The constructor fails if fs.Parse() has not been called, or if any required
+// flags were not provided. Depend on Optional[T] to handle the absence of the
+// flags without failing.
+//
+// The flags are registered before the constructor is declared, so that they
+// are available to fs.Parse() even if con is a Catalog that is not yet used by
+// any container. As such, they are registered even if the declaration is
+// disabled by When() or Profile(), or rejected because of a collision with an
+// existing constructor. WithFlags() panics if a flag with the same name is
+// already registered on fs, such as when it is called twice with the same T.
+func WithFlags[T any](
+	con ContainerAware,
+	fs *flag.FlagSet,
+	options ...WithOption,
+) {
+	var cfg T
+
+	rv := reflect.ValueOf(&cfg).Elem()
+	if rv.Kind() != reflect.Struct {
+		panic(fmt.Sprintf(
+			"cannot declare flags for %s because it is not a struct",
+			rv.Type(),
+		))
+	}
+
+	var required []string
+	registerFlags(fs, rv, "", &required)
+
+	With0(
+		con,
+		func(ctx Context) (T, error) {
+			if !fs.Parsed() {
+				return cfg, errors.New("the flag set has not been parsed")
+			}
+
+			provided := map[string]struct{}{}
+			fs.Visit(func(f *flag.Flag) {
+				provided[f.Name] = struct{}{}
+			})
+
+			var missing []string
+			for _, name := range required {
+				if _, ok := provided[name]; !ok {
+					missing = append(missing, "-"+name)
+				}
+			}
+
+			if len(missing) != 0 {
+				return cfg, fmt.Errorf(
+					"required flag(s) not provided: %s",
+					strings.Join(missing, ", "),
+				)
+			}
+
+			return cfg, nil
+		},
+		options...,
+	)
+}
+
+// registerFlags registers a flag on fs for each field of the struct v,
+// prefixing each flag name with prefix.
+//
+// The names of required flags are appended to required.
+func registerFlags(
+	fs *flag.FlagSet,
+	v reflect.Value,
+	prefix string,
+	required *[]string,
+) {
+	for i := 0; i < v.NumField(); i++ {
+		f := v.Type().Field(i)
+		if !f.IsExported() {
+			continue
+		}
+
+		name, ok := f.Tag.Lookup("flag")
+		if name == "-" {
+			continue
+		}
+		if !ok {
+			name = identifier.ToKebabCase(f.Name)
+		}
+
+		if parse.IsNested(f.Type) {
+			if f.Anonymous {
+				registerFlags(fs, v.Field(i), prefix, required)
+			} else {
+				registerFlags(fs, v.Field(i), prefix+name+"-", required)
+			}
+			continue
+		}
+
+		name = prefix + name
+		value := flagValue{v.Field(i)}
+
+		if fs.Lookup(name) != nil {
+			panic(fmt.Sprintf(
+				"cannot register the -%s flag because it is already registered",
+				name,
+			))
+		}
+
+		if def, ok := f.Tag.Lookup("default"); ok {
+			if err := value.Set(def); err != nil {
+				panic(fmt.Sprintf(
+					"invalid default value for the -%s flag: %s",
+					name,
+					err,
+				))
+			}
+		}
+
+		if f.Tag.Get("required") == "true" {
+			*required = append(*required, name)
+		}
+
+		fs.Var(value, name, f.Tag.Get("usage"))
+	}
+}
+
+// flagValue is an implementation of flag.Value that parses flags into a
+// struct field.
+type flagValue struct {
+	v reflect.Value
+}
+
+func (f flagValue) String() string {
+	if !f.v.IsValid() {
+		return ""
+	}
+
+	return fmt.Sprint(f.v.Interface())
+}
+
+func (f flagValue) Set(s string) error {
+	return parse.Value(f.v, s)
+}
+
+// IsBoolFlag returns true if the flag can be provided without a value.
+func (f flagValue) IsBoolFlag() bool {
+	return f.v.IsValid() && f.v.Kind() == reflect.Bool
+}
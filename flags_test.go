@@ -0,0 +1,155 @@
+package imbue_test
+
+import (
+	"context"
+	"flag"
+	"io"
+	"time"
+
+	"github.com/dogmatiq/imbue"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+type FlagConfig struct {
+	ListenAddress string `required:"true" usage:"the address to listen on"`
+	Timeout       time.Duration
+	Workers       int    `default:"4"`
+	Verbose       bool   `flag:"v"`
+	Ignored       string `flag:"-"`
+	Database      struct {
+		DSN string
+	}
+}
+
+var _ = Describe("func WithFlags()", func() {
+	var (
+		container *imbue.Container
+		flags     *flag.FlagSet
+	)
+
+	BeforeEach(func() {
+		container = imbue.New()
+
+		flags = flag.NewFlagSet("<name>", flag.ContinueOnError)
+		flags.SetOutput(io.Discard)
+
+		imbue.WithFlags[FlagConfig](container, flags)
+	})
+
+	AfterEach(func() {
+		container.Close()
+	})
+
+	It("registers a flag for each field", func() {
+		var names []string
+		flags.VisitAll(func(f *flag.Flag) {
+			names = append(names, f.Name)
+		})
+
+		Expect(names).To(ConsistOf(
+			"listen-address",
+			"timeout",
+			"workers",
+			"v",
+			"database-dsn",
+		))
+
+		f := flags.Lookup("listen-address")
+		Expect(f.Usage).To(Equal("the address to listen on"))
+
+		f = flags.Lookup("workers")
+		Expect(f.DefValue).To(Equal("4"))
+	})
+
+	It("populates the fields from the parsed flags", func() {
+		err := flags.Parse([]string{
+			"-listen-address", ":8080",
+			"-timeout", "10s",
+			"-v",
+			"-database-dsn", "<dsn>",
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+
+		err = imbue.Invoke1(
+			context.Background(),
+			container,
+			func(ctx context.Context, cfg FlagConfig) error {
+				Expect(cfg.ListenAddress).To(Equal(":8080"))
+				Expect(cfg.Timeout).To(Equal(10 * time.Second))
+				Expect(cfg.Workers).To(Equal(4))
+				Expect(cfg.Verbose).To(BeTrue())
+				Expect(cfg.Database.DSN).To(Equal("<dsn>"))
+				return nil
+			},
+		)
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+
+	It("returns an error if the flags have not been parsed", func() {
+		err := imbue.Invoke1(
+			context.Background(),
+			container,
+			func(ctx context.Context, cfg FlagConfig) error {
+				return nil
+			},
+		)
+		Expect(err).To(MatchError(MatchRegexp(
+			`^imbue_test\.FlagConfig constructor \(flags_test\.go:\d+\) failed: the flag set has not been parsed$`,
+		)))
+	})
+
+	It("returns an error if required flags are not provided", func() {
+		err := flags.Parse(nil)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		err = imbue.Invoke1(
+			context.Background(),
+			container,
+			func(ctx context.Context, cfg FlagConfig) error {
+				return nil
+			},
+		)
+		Expect(err).To(MatchError(MatchRegexp(
+			`^imbue_test\.FlagConfig constructor \(flags_test\.go:\d+\) failed: required flag\(s\) not provided: -listen-address$`,
+		)))
+	})
+
+	It("allows an optional dependency when required flags are not provided", func() {
+		err := flags.Parse(nil)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		err = imbue.Invoke1(
+			context.Background(),
+			container,
+			func(ctx context.Context, opt imbue.Optional[FlagConfig]) error {
+				_, err := opt.Value()
+				Expect(err).To(HaveOccurred())
+				return nil
+			},
+		)
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+
+	It("panics if the type is not a struct", func() {
+		Expect(func() {
+			imbue.WithFlags[string](container, flags)
+		}).To(PanicWith("cannot declare flags for string because it is not a struct"))
+	})
+
+	It("panics if a default value is invalid", func() {
+		type Invalid struct {
+			Count int `default:"<count>"`
+		}
+
+		Expect(func() {
+			imbue.WithFlags[Invalid](container, flags)
+		}).To(PanicWith(`invalid default value for the -count flag: strconv.ParseInt: parsing "<count>": invalid syntax`))
+	})
+
+	It("panics if a flag is already registered", func() {
+		Expect(func() {
+			imbue.WithFlags[FlagConfig](container, flags)
+		}).To(PanicWith("cannot register the -listen-address flag because it is already registered"))
+	})
+})
@@ -0,0 +1,45 @@
+package imbue_test
+
+import (
+	"context"
+	"flag"
+	"fmt"
+
+	"github.com/dogmatiq/imbue"
+)
+
+func ExampleWithFlags() {
+	con := imbue.New()
+	defer con.Close()
+
+	// Declare a configuration struct to use within the example.
+	type ServerConfig struct {
+		ListenAddress string `required:"true"`
+		MaxRequests   int    `default:"100"`
+	}
+
+	// Register the fields of ServerConfig as flags, and declare a constructor
+	// that returns their values once the flags have been parsed.
+	fs := flag.NewFlagSet("server", flag.ExitOnError)
+	imbue.WithFlags[ServerConfig](con, fs)
+
+	if err := fs.Parse([]string{"-listen-address", ":8080"}); err != nil {
+		panic(err)
+	}
+
+	// Invoke a function that depends on the configuration.
+	err := imbue.Invoke1(
+		context.Background(),
+		con,
+		func(ctx context.Context, cfg ServerConfig) error {
+			fmt.Println(cfg.ListenAddress, cfg.MaxRequests)
+			return nil
+		},
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	// Output:
+	// :8080 100
+}
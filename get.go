@@ -0,0 +1,50 @@
+package imbue
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// Get returns the value of type T from the container.
+//
+// It panics if no constructor is declared for T, or for any of its
+// dependencies.
+func Get[T any](
+	ctx context.Context,
+	con *Container,
+) (T, error) {
+	if err := con.Err(); err != nil {
+		var zero T
+		return zero, err
+	}
+
+	v, err := get[T](con).Resolve(ctx)
+	if err != nil {
+		var u undeclaredConstructorError
+		if errors.As(err, &u) {
+			panic(fmt.Sprintf(
+				"%s, requested at %s",
+				u,
+				findLocation(),
+			))
+		}
+	}
+
+	return v, err
+}
+
+// MustGet returns the value of type T from the container.
+//
+// It panics if the value can not be constructed.
+func MustGet[T any](
+	ctx context.Context,
+	con *Container,
+) T {
+	v, err := Get[T](ctx, con)
+	if err != nil {
+		panic(err)
+	}
+
+	return v
+}
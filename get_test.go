@@ -0,0 +1,108 @@
+package imbue_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/dogmatiq/imbue"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("func Get()", func() {
+	var container *imbue.Container
+
+	BeforeEach(func() {
+		container = imbue.New()
+	})
+
+	AfterEach(func() {
+		container.Close()
+	})
+
+	It("returns the value from the container", func() {
+		imbue.With0(
+			container,
+			func(ctx imbue.Context) (Concrete1, error) {
+				return "<concrete>", nil
+			},
+		)
+
+		v, err := imbue.Get[Concrete1](context.Background(), container)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(v).To(Equal(Concrete1("<concrete>")))
+	})
+
+	It("returns an error when the constructor returns an error", func() {
+		imbue.With0(
+			container,
+			func(ctx imbue.Context) (Concrete1, error) {
+				return "", errors.New("<error>")
+			},
+		)
+
+		_, err := imbue.Get[Concrete1](context.Background(), container)
+		Expect(err).To(
+			MatchError(
+				MatchRegexp(
+					`imbue_test\.Concrete1 constructor \(get_test\.go:\d+\) failed: <error>`,
+				),
+			),
+		)
+	})
+
+	It("panics with the caller's location when the type is not declared", func() {
+		Expect(func() {
+			imbue.Get[Concrete1](context.Background(), container)
+		}).To(
+			PanicWith(
+				MatchRegexp(
+					`^no constructor is declared for imbue_test\.Concrete1, requested at get_test\.go:\d+$`,
+				),
+			),
+		)
+	})
+})
+
+var _ = Describe("func MustGet()", func() {
+	var container *imbue.Container
+
+	BeforeEach(func() {
+		container = imbue.New()
+	})
+
+	AfterEach(func() {
+		container.Close()
+	})
+
+	It("returns the value from the container", func() {
+		imbue.With0(
+			container,
+			func(ctx imbue.Context) (Concrete1, error) {
+				return "<concrete>", nil
+			},
+		)
+
+		v := imbue.MustGet[Concrete1](context.Background(), container)
+		Expect(v).To(Equal(Concrete1("<concrete>")))
+	})
+
+	It("panics when the constructor returns an error", func() {
+		imbue.With0(
+			container,
+			func(ctx imbue.Context) (Concrete1, error) {
+				return "", errors.New("<error>")
+			},
+		)
+
+		Expect(func() {
+			imbue.MustGet[Concrete1](context.Background(), container)
+		}).To(
+			PanicWith(
+				MatchError(
+					MatchRegexp(`failed: <error>$`),
+				),
+			),
+		)
+	})
+})
@@ -0,0 +1,37 @@
+package imbue_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dogmatiq/imbue"
+)
+
+func ExampleGet() {
+	con := imbue.New()
+	defer con.Close()
+
+	// Declare a type to use as a dependency within the example.
+	type Dependency struct {
+		Value string
+	}
+
+	// Declare a constructor for the Dependency type.
+	imbue.With0(
+		con,
+		func(ctx imbue.Context) (Dependency, error) {
+			return Dependency{"<value>"}, nil
+		},
+	)
+
+	// Obtain the dependency from the container without using a callback.
+	dep, err := imbue.Get[Dependency](context.Background(), con)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(dep.Value)
+
+	// Output:
+	// <value>
+}
@@ -0,0 +1,279 @@
+package imbue
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+)
+
+// Graph is a machine-readable description of the dependencies declared within
+// a container.
+//
+// Its JSON representation is stable, such that graphs produced from different
+// versions of an application can be compared.
+type Graph struct {
+	// Nodes is the set of declarations within the container, sorted by ID.
+	Nodes []GraphNode `json:"nodes"`
+
+	// Roots is the set of calls to InvokeX() and GoX() that have used the
+	// container, sorted by location.
+	Roots []GraphRoot `json:"roots"`
+
+	// Edges is the set of dependency relationships between the nodes, sorted
+	// by the ID of the dependent node or root, then the ID of the dependency.
+	Edges []GraphEdge `json:"edges"`
+}
+
+// GraphNode describes a single declaration within a dependency graph.
+type GraphNode struct {
+	// ID uniquely identifies the node within the graph.
+	ID string `json:"id"`
+
+	// Type is the type of the dependency value.
+	//
+	// For named and grouped dependencies, this is the type of the underlying
+	// value, not the ByName or FromGroup type.
+	Type string `json:"type"`
+
+	// Package is the path of the package in which Type is declared, if any.
+	//
+	// For pointer, slice, array, map and channel types, this is the package in
+	// which the element type is declared.
+	Package string `json:"package,omitempty"`
+
+	// Name is the name of the dependency, if it is a named dependency.
+	Name string `json:"name,omitempty"`
+
+	// Group is the group that the dependency belongs to, if it is a grouped
+	// dependency.
+	Group string `json:"group,omitempty"`
+
+	// Constructor is the location of the dependency's constructor, if one has
+	// been declared.
+	Constructor *GraphLocation `json:"constructor,omitempty"`
+
+	// Decorators are the locations of the dependency's decorators, in the
+	// order they are applied.
+	Decorators []GraphLocation `json:"decorators,omitempty"`
+
+	// Implicit is true if the dependency is declared implicitly by the
+	// container, such as Optional[T].
+	Implicit bool `json:"implicit"`
+
+	// Constructed is true if the dependency value has already been
+	// constructed.
+	Constructed bool `json:"constructed"`
+}
+
+// GraphRoot describes a call to InvokeX() or GoX() within a dependency graph.
+type GraphRoot struct {
+	// ID uniquely identifies the root within the graph.
+	ID string `json:"id"`
+
+	// Kind is "invoke" for calls to InvokeX(), or "go" for calls to GoX().
+	Kind string `json:"kind"`
+
+	// Location is the location of the call.
+	Location GraphLocation `json:"location"`
+}
+
+// GraphLocation is a location within source code.
+type GraphLocation struct {
+	// File is the path of the source file, in the form produced by the
+	// -trimpath build flag; that is, the import path of the package followed
+	// by the file's name.
+	//
+	// It does not depend on the location of the source code on the machine
+	// that built the application, such that graphs produced on different
+	// machines can be compared.
+	File string `json:"file"`
+
+	// Line is the line number within the file.
+	Line int `json:"line"`
+}
+
+// GraphEdge describes a dependency of one node upon another.
+type GraphEdge struct {
+	// From is the ID of the dependent node or root.
+	From string `json:"from"`
+
+	// To is the ID of the node that is depended upon.
+	To string `json:"to"`
+}
+
+// WriteJSON writes the JSON representation of the graph to w.
+func (g Graph) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(g)
+}
+
+// Graph returns a machine-readable description of the dependencies declared
+// within the container.
+func (c *Container) Graph() Graph {
+	c.m.Lock()
+	declarations := sortDeclarations(c.declarations)
+	invocations := sortInvocations(c.invocations)
+	c.m.Unlock()
+
+	g := Graph{
+		Nodes: []GraphNode{},
+		Roots: []GraphRoot{},
+		Edges: []GraphEdge{},
+	}
+
+	for _, inv := range invocations {
+		r := graphRootOf(inv)
+		g.Roots = append(g.Roots, r)
+
+		for _, dep := range inv.deps {
+			g.Edges = append(
+				g.Edges,
+				GraphEdge{
+					From: r.ID,
+					To:   nodeID(dep.Type()),
+				},
+			)
+		}
+	}
+
+	for _, d := range declarations {
+		g.Nodes = append(g.Nodes, graphNodeOf(d))
+
+		for _, dep := range d.Dependencies() {
+			g.Edges = append(
+				g.Edges,
+				GraphEdge{
+					From: nodeID(d.Type()),
+					To:   nodeID(dep.Type()),
+				},
+			)
+		}
+	}
+
+	sort.Slice(
+		g.Nodes,
+		func(i, j int) bool {
+			return g.Nodes[i].ID < g.Nodes[j].ID
+		},
+	)
+
+	sort.Slice(
+		g.Edges,
+		func(i, j int) bool {
+			if g.Edges[i].From != g.Edges[j].From {
+				return g.Edges[i].From < g.Edges[j].From
+			}
+			return g.Edges[i].To < g.Edges[j].To
+		},
+	)
+
+	return g
+}
+
+// graphNodeOf returns the graph node that describes d.
+func graphNodeOf(d declaration) GraphNode {
+	v, isConstructed := d.ConstructedValue()
+
+	t := d.Type()
+	n := GraphNode{
+		ID:          nodeID(t),
+		Implicit:    d.IsImplicit(),
+		Constructed: isConstructed,
+	}
+
+	if w, ok := v.(wrapper); ok {
+		u := w.unwrap()
+		t = u.Type
+		n.Name = u.Name
+		n.Group = u.Group
+	}
+
+	n.Type = t.String()
+	n.Package = packagePathOf(t)
+
+	if d.IsDeclared() {
+		l := graphLocationOf(d.BestLocation())
+		n.Constructor = &l
+	}
+
+	for _, loc := range d.DecoratorLocations() {
+		n.Decorators = append(n.Decorators, graphLocationOf(loc))
+	}
+
+	return n
+}
+
+// graphRootOf returns the graph root that describes inv.
+func graphRootOf(inv invocation) GraphRoot {
+	kind := "invoke"
+	if inv.isGoroutine {
+		kind = "go"
+	}
+
+	loc := graphLocationOf(inv.loc)
+
+	return GraphRoot{
+		ID:       fmt.Sprintf("%s@%s:%d", kind, loc.File, loc.Line),
+		Kind:     kind,
+		Location: loc,
+	}
+}
+
+// graphLocationOf returns the graph location that describes loc.
+func graphLocationOf(loc location) GraphLocation {
+	return GraphLocation{
+		File: loc.TrimmedFile(),
+		Line: loc.Line,
+	}
+}
+
+// nodeID returns the ID of the graph node for the declaration of type t.
+//
+// Unlike t.String(), the ID includes the full package path of each named type,
+// including those referred to by pointer, slice, array, map and channel types,
+// so that it is unique even if there are several packages with the same name.
+func nodeID(t reflect.Type) string {
+	switch t.Kind() {
+	case reflect.Pointer:
+		return "*" + nodeID(t.Elem())
+	case reflect.Slice:
+		return "[]" + nodeID(t.Elem())
+	case reflect.Array:
+		return fmt.Sprintf("[%d]%s", t.Len(), nodeID(t.Elem()))
+	case reflect.Map:
+		return fmt.Sprintf("map[%s]%s", nodeID(t.Key()), nodeID(t.Elem()))
+	case reflect.Chan:
+		switch t.ChanDir() {
+		case reflect.RecvDir:
+			return "<-chan " + nodeID(t.Elem())
+		case reflect.SendDir:
+			return "chan<- " + nodeID(t.Elem())
+		default:
+			return "chan " + nodeID(t.Elem())
+		}
+	}
+
+	if t.Name() != "" && t.PkgPath() != "" {
+		return t.PkgPath() + "." + t.Name()
+	}
+
+	return t.String()
+}
+
+// packagePathOf returns the path of the package in which t is declared.
+//
+// For pointer, slice, array, map and channel types it returns the package of
+// the element type.
+func packagePathOf(t reflect.Type) string {
+	for {
+		switch t.Kind() {
+		case reflect.Pointer, reflect.Slice, reflect.Array, reflect.Map, reflect.Chan:
+			t = t.Elem()
+		default:
+			return t.PkgPath()
+		}
+	}
+}
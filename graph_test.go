@@ -0,0 +1,297 @@
+package imbue_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"runtime"
+
+	"github.com/dogmatiq/imbue"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("type Container", func() {
+	var container *imbue.Container
+
+	BeforeEach(func() {
+		container = imbue.New()
+	})
+
+	AfterEach(func() {
+		container.Close()
+	})
+
+	Describe("func Graph()", func() {
+		It("describes the declarations and their dependencies", func() {
+			imbue.With0(
+				container,
+				func(ctx imbue.Context) (Concrete1, error) {
+					return "<concrete>", nil
+				},
+			)
+
+			imbue.Decorate0(
+				container,
+				func(ctx imbue.Context, v Concrete1) (Concrete1, error) {
+					return v, nil
+				},
+			)
+
+			imbue.With2(
+				container,
+				func(
+					ctx imbue.Context,
+					dep1 Concrete1,
+					dep2 imbue.Optional[Concrete3],
+				) (Concrete2, error) {
+					return "<concrete>", nil
+				},
+			)
+
+			imbue.With1Named[Foreground](
+				container,
+				func(ctx imbue.Context, dep Concrete1) (Color, error) {
+					return "<color>", nil
+				},
+			)
+
+			imbue.With0Grouped[ServiceA](
+				container,
+				func(ctx imbue.Context) (Concrete3, error) {
+					return "<concrete>", nil
+				},
+			)
+
+			_, _, line, _ := runtime.Caller(0)
+			err := imbue.Invoke1(
+				context.Background(),
+				container,
+				func(ctx context.Context, dep Concrete1) error {
+					return nil
+				},
+			)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			g := container.Graph()
+			file := "github.com/dogmatiq/imbue/graph_test.go"
+			rootID := fmt.Sprintf("invoke@%s:%d", file, line+1)
+
+			Expect(g.Roots).To(Equal([]imbue.GraphRoot{
+				{
+					ID:   rootID,
+					Kind: "invoke",
+					Location: imbue.GraphLocation{
+						File: file,
+						Line: line + 1,
+					},
+				},
+			}))
+
+			var ids []string
+			nodes := map[string]imbue.GraphNode{}
+			for _, n := range g.Nodes {
+				ids = append(ids, n.ID)
+				nodes[n.Type+n.Name+n.Group] = n
+			}
+
+			Expect(ids).To(Equal([]string{
+				"github.com/dogmatiq/imbue.ByName[github.com/dogmatiq/imbue_test.Foreground,github.com/dogmatiq/imbue_test.Color]",
+				"github.com/dogmatiq/imbue.FromGroup[github.com/dogmatiq/imbue_test.ServiceA,github.com/dogmatiq/imbue_test.Concrete3]",
+				"github.com/dogmatiq/imbue.Optional[github.com/dogmatiq/imbue_test.Concrete3]",
+				"github.com/dogmatiq/imbue_test.Concrete1",
+				"github.com/dogmatiq/imbue_test.Concrete2",
+				"github.com/dogmatiq/imbue_test.Concrete3",
+			}))
+
+			n := nodes["imbue_test.Concrete1"]
+			Expect(n.Package).To(Equal("github.com/dogmatiq/imbue_test"))
+			Expect(n.Constructor.File).To(Equal(file))
+			Expect(n.Decorators).To(HaveLen(1))
+			Expect(n.Decorators[0].File).To(Equal(file))
+			Expect(n.Implicit).To(BeFalse())
+			Expect(n.Constructed).To(BeTrue())
+
+			n = nodes["imbue_test.Concrete2"]
+			Expect(n.Constructor).NotTo(BeNil())
+			Expect(n.Decorators).To(BeEmpty())
+			Expect(n.Constructed).To(BeFalse())
+
+			n = nodes["imbue_test.Concrete3"]
+			Expect(n.Constructor).To(BeNil())
+
+			n = nodes["imbue.Optional[github.com/dogmatiq/imbue_test.Concrete3]"]
+			Expect(n.Package).To(Equal("github.com/dogmatiq/imbue"))
+			Expect(n.Implicit).To(BeTrue())
+
+			n = nodes["imbue_test.ColorForeground"]
+			Expect(n.Name).To(Equal("Foreground"))
+			Expect(n.Constructor).NotTo(BeNil())
+
+			n = nodes["imbue_test.Concrete3ServiceA"]
+			Expect(n.Group).To(Equal("ServiceA"))
+
+			Expect(g.Edges).To(Equal([]imbue.GraphEdge{
+				{
+					From: "github.com/dogmatiq/imbue.ByName[github.com/dogmatiq/imbue_test.Foreground,github.com/dogmatiq/imbue_test.Color]",
+					To:   "github.com/dogmatiq/imbue_test.Concrete1",
+				},
+				{
+					From: "github.com/dogmatiq/imbue.Optional[github.com/dogmatiq/imbue_test.Concrete3]",
+					To:   "github.com/dogmatiq/imbue_test.Concrete3",
+				},
+				{
+					From: "github.com/dogmatiq/imbue_test.Concrete2",
+					To:   "github.com/dogmatiq/imbue.Optional[github.com/dogmatiq/imbue_test.Concrete3]",
+				},
+				{
+					From: "github.com/dogmatiq/imbue_test.Concrete2",
+					To:   "github.com/dogmatiq/imbue_test.Concrete1",
+				},
+				{
+					From: rootID,
+					To:   "github.com/dogmatiq/imbue_test.Concrete1",
+				},
+			}))
+		})
+
+		It("includes calls to Invoke0() and Go0() as roots", func() {
+			_, _, invokeLine, _ := runtime.Caller(0)
+			err := imbue.Invoke0(
+				context.Background(),
+				container,
+				func(ctx context.Context) error {
+					return nil
+				},
+			)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			wg := container.WaitGroup(context.Background())
+			_, _, goLine, _ := runtime.Caller(0)
+			imbue.Go0(
+				wg,
+				func(ctx context.Context) error {
+					return nil
+				},
+			)
+			Expect(wg.Wait()).To(Succeed())
+
+			g := container.Graph()
+			file := "github.com/dogmatiq/imbue/graph_test.go"
+
+			Expect(g.Roots).To(Equal([]imbue.GraphRoot{
+				{
+					ID:   fmt.Sprintf("invoke@%s:%d", file, invokeLine+1),
+					Kind: "invoke",
+					Location: imbue.GraphLocation{
+						File: file,
+						Line: invokeLine + 1,
+					},
+				},
+				{
+					ID:   fmt.Sprintf("go@%s:%d", file, goLine+1),
+					Kind: "go",
+					Location: imbue.GraphLocation{
+						File: file,
+						Line: goLine + 1,
+					},
+				},
+			}))
+			Expect(g.Edges).To(BeEmpty())
+		})
+
+		It("qualifies pointer types with the package of the element type", func() {
+			imbue.With0(
+				container,
+				func(ctx imbue.Context) (*Labeled1, error) {
+					return &Labeled1{"<label>"}, nil
+				},
+			)
+
+			imbue.With1(
+				container,
+				func(ctx imbue.Context, dep *Labeled1) ([]*Labeled2, error) {
+					return nil, nil
+				},
+			)
+
+			g := container.Graph()
+			Expect(g.Nodes).To(HaveLen(2))
+
+			n := g.Nodes[0]
+			Expect(n.ID).To(Equal("*github.com/dogmatiq/imbue_test.Labeled1"))
+			Expect(n.Type).To(Equal("*imbue_test.Labeled1"))
+			Expect(n.Package).To(Equal("github.com/dogmatiq/imbue_test"))
+
+			n = g.Nodes[1]
+			Expect(n.ID).To(Equal("[]*github.com/dogmatiq/imbue_test.Labeled2"))
+			Expect(n.Type).To(Equal("[]*imbue_test.Labeled2"))
+			Expect(n.Package).To(Equal("github.com/dogmatiq/imbue_test"))
+
+			Expect(g.Edges).To(Equal([]imbue.GraphEdge{
+				{
+					From: "[]*github.com/dogmatiq/imbue_test.Labeled2",
+					To:   "*github.com/dogmatiq/imbue_test.Labeled1",
+				},
+			}))
+		})
+	})
+})
+
+var _ = Describe("type Graph", func() {
+	var container *imbue.Container
+
+	BeforeEach(func() {
+		container = imbue.New()
+	})
+
+	AfterEach(func() {
+		container.Close()
+	})
+
+	Describe("func WriteJSON()", func() {
+		It("writes the graph as JSON", func() {
+			_, _, line, _ := runtime.Caller(0)
+			imbue.With1(
+				container,
+				func(ctx imbue.Context, dep Concrete1) (Concrete2, error) {
+					return "<concrete>", nil
+				},
+			)
+
+			var buf bytes.Buffer
+			err := container.Graph().WriteJSON(&buf)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			Expect(buf.String()).To(MatchJSON(fmt.Sprintf(
+				`{
+					"nodes": [
+						{
+							"id": "github.com/dogmatiq/imbue_test.Concrete1",
+							"type": "imbue_test.Concrete1",
+							"package": "github.com/dogmatiq/imbue_test",
+							"implicit": false,
+							"constructed": false
+						},
+						{
+							"id": "github.com/dogmatiq/imbue_test.Concrete2",
+							"type": "imbue_test.Concrete2",
+							"package": "github.com/dogmatiq/imbue_test",
+							"constructor": {"file": "github.com/dogmatiq/imbue/graph_test.go", "line": %d},
+							"implicit": false,
+							"constructed": false
+						}
+					],
+					"roots": [],
+					"edges": [
+						{
+							"from": "github.com/dogmatiq/imbue_test.Concrete2",
+							"to": "github.com/dogmatiq/imbue_test.Concrete1"
+						}
+					]
+				}`,
+				line+1,
+			)))
+		})
+	})
+})
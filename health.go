@@ -0,0 +1,242 @@
+package imbue
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"runtime/debug"
+	"sort"
+	"sync"
+	"time"
+)
+
+// HealthChecker is an interface for dependencies that can report on their own
+// health.
+type HealthChecker interface {
+	// Check returns an error if the dependency is unhealthy.
+	Check(ctx context.Context) error
+}
+
+// HealthReport is a report of the health of a container's dependencies.
+type HealthReport struct {
+	// Healthy is true if all of the checks passed.
+	Healthy bool `json:"healthy"`
+
+	// Checks contains the result of each check, sorted by type, name and
+	// group.
+	Checks []HealthCheck `json:"checks"`
+}
+
+// HealthCheck is the result of checking the health of a single dependency.
+type HealthCheck struct {
+	// Type is the type of the dependency.
+	Type string `json:"type"`
+
+	// Name is the name of the dependency, if it was declared using
+	// WithXNamed().
+	Name string `json:"name,omitempty"`
+
+	// Group is the group that contains the dependency, if it was declared
+	// using WithXGrouped().
+	Group string `json:"group,omitempty"`
+
+	// Healthy is true if the check passed.
+	Healthy bool `json:"healthy"`
+
+	// Error is the error message returned by the check, if it failed.
+	Error string `json:"error,omitempty"`
+
+	// Duration is the time taken to perform the check.
+	Duration time.Duration `json:"duration"`
+}
+
+// HealthOption is an option that changes the behavior of a call to
+// Container.Health().
+type HealthOption interface {
+	applyHealthOption(*healthOptions)
+}
+
+// healthOptions is the set of options that apply to a single call to
+// Container.Health().
+type healthOptions struct {
+	// timeout is the maximum time allowed for each individual check.
+	timeout time.Duration
+}
+
+// newHealthOptions returns the healthOptions described by the given options.
+func newHealthOptions(options []HealthOption) healthOptions {
+	opts := healthOptions{
+		timeout: 5 * time.Second,
+	}
+
+	for _, opt := range options {
+		opt.applyHealthOption(&opts)
+	}
+
+	return opts
+}
+
+// CheckTimeout is a HealthOption that sets the maximum time allowed for each
+// individual health check. The default is 5 seconds.
+func CheckTimeout(d time.Duration) HealthOption {
+	return option{
+		forHealth: func(opts *healthOptions) {
+			opts.timeout = d
+		},
+	}
+}
+
+// Health checks the health of every dependency that has already been
+// constructed and implements the HealthChecker interface.
+//
+// Dependencies that have not yet been constructed are not checked, and are not
+// constructed by this method. Nor does it wait for any dependency that is
+// currently being constructed.
+//
+// The checks are performed concurrently, each with its own timeout. A check
+// that does not return within the timeout is reported as unhealthy, even if it
+// does not observe the cancellation of its context.
+func (c *Container) Health(
+	ctx context.Context,
+	options ...HealthOption,
+) HealthReport {
+	opts := newHealthOptions(options)
+
+	c.m.Lock()
+	declarations := sortDeclarations(c.declarations)
+	c.m.Unlock()
+
+	var (
+		checks   = []HealthCheck{}
+		checkers []HealthChecker
+	)
+
+	for _, d := range declarations {
+		v, ok := d.ConstructedValue()
+		if !ok {
+			continue
+		}
+
+		check := HealthCheck{
+			Type: d.Type().String(),
+		}
+
+		if w, ok := v.(wrapper); ok {
+			u := w.unwrap()
+			v = u.Value
+			check.Type = u.Type.String()
+			check.Name = u.Name
+			check.Group = u.Group
+		}
+
+		if checker, ok := v.(HealthChecker); ok {
+			checks = append(checks, check)
+			checkers = append(checkers, checker)
+		}
+	}
+
+	var wg sync.WaitGroup
+
+	for i, checker := range checkers {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			ctx, cancel := context.WithTimeout(ctx, opts.timeout)
+			defer cancel()
+
+			start := time.Now()
+			err := checkWithTimeout(ctx, checker)
+
+			checks[i].Duration = time.Since(start)
+			checks[i].Healthy = err == nil
+			if err != nil {
+				checks[i].Error = err.Error()
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	report := HealthReport{
+		Healthy: true,
+		Checks:  checks,
+	}
+
+	for _, check := range report.Checks {
+		if !check.Healthy {
+			report.Healthy = false
+		}
+	}
+
+	sort.SliceStable(
+		report.Checks,
+		func(i, j int) bool {
+			a, b := report.Checks[i], report.Checks[j]
+			if a.Type != b.Type {
+				return a.Type < b.Type
+			}
+			if a.Name != b.Name {
+				return a.Name < b.Name
+			}
+			return a.Group < b.Group
+		},
+	)
+
+	return report
+}
+
+// checkWithTimeout calls c.Check(), failing if it does not return before ctx
+// is done.
+//
+// If the check is abandoned because it takes too long, it continues to run in
+// the background until it returns.
+func checkWithTimeout(ctx context.Context, c HealthChecker) error {
+	done := make(chan error, 1)
+
+	go func() {
+		done <- check(ctx, c)
+	}()
+
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// check calls c.Check(), returning an error if it panics.
+func check(ctx context.Context, c HealthChecker) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = PanicError{r, debug.Stack()}
+		}
+	}()
+
+	return c.Check(ctx)
+}
+
+// HealthHandler returns an HTTP handler that serves the container's health
+// report as JSON.
+//
+// It responds with a 200 (OK) status if all checks pass; otherwise, it responds
+// with 503 (Service Unavailable).
+func (c *Container) HealthHandler(options ...HealthOption) http.Handler {
+	return http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			report := c.Health(r.Context(), options...)
+
+			w.Header().Set("Content-Type", "application/json")
+
+			if report.Healthy {
+				w.WriteHeader(http.StatusOK)
+			} else {
+				w.WriteHeader(http.StatusServiceUnavailable)
+			}
+
+			json.NewEncoder(w).Encode(report)
+		},
+	)
+}
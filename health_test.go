@@ -0,0 +1,275 @@
+package imbue_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/dogmatiq/imbue"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// testChecker is an implementation of imbue.HealthChecker used for testing.
+type testChecker struct {
+	check func(ctx context.Context) error
+}
+
+func (c *testChecker) Check(ctx context.Context) error {
+	return c.check(ctx)
+}
+
+type (
+	Checker1 struct{ *testChecker }
+	Checker2 struct{ *testChecker }
+
+	// Secondary is a name for a Checker2.
+	Secondary imbue.Name[Checker2]
+)
+
+var _ = Describe("type Container", func() {
+	Describe("func Health()", func() {
+		var container *imbue.Container
+
+		BeforeEach(func() {
+			container = imbue.New()
+
+			imbue.With0(
+				container,
+				func(ctx imbue.Context) (Checker1, error) {
+					return Checker1{&testChecker{
+						func(ctx context.Context) error {
+							return nil
+						},
+					}}, nil
+				},
+			)
+
+			imbue.With0Named[Secondary](
+				container,
+				func(ctx imbue.Context) (Checker2, error) {
+					return Checker2{&testChecker{
+						func(ctx context.Context) error {
+							return errors.New("<error>")
+						},
+					}}, nil
+				},
+			)
+		})
+
+		AfterEach(func() {
+			container.Close()
+		})
+
+		It("checks dependencies that have been constructed", func() {
+			err := imbue.Invoke2(
+				context.Background(),
+				container,
+				func(
+					ctx context.Context,
+					dep1 Checker1,
+					dep2 imbue.ByName[Secondary, Checker2],
+				) error {
+					return nil
+				},
+			)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			report := container.Health(context.Background())
+			Expect(report.Healthy).To(BeFalse())
+			Expect(report.Checks).To(HaveLen(2))
+
+			Expect(report.Checks[0].Type).To(Equal("imbue_test.Checker1"))
+			Expect(report.Checks[0].Healthy).To(BeTrue())
+			Expect(report.Checks[0].Error).To(BeEmpty())
+
+			Expect(report.Checks[1].Type).To(Equal("imbue_test.Checker2"))
+			Expect(report.Checks[1].Name).To(Equal("Secondary"))
+			Expect(report.Checks[1].Healthy).To(BeFalse())
+			Expect(report.Checks[1].Error).To(Equal("<error>"))
+		})
+
+		It("does not check dependencies that have not been constructed", func() {
+			report := container.Health(context.Background())
+			Expect(report.Healthy).To(BeTrue())
+			Expect(report.Checks).To(BeEmpty())
+		})
+
+		It("does not wait for dependencies that are being constructed", func() {
+			_, err := imbue.Get[Checker1](context.Background(), container)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			started := make(chan struct{})
+			release := make(chan struct{})
+			defer close(release)
+
+			imbue.With0(
+				container,
+				func(ctx imbue.Context) (Checker2, error) {
+					close(started)
+					<-release
+					return Checker2{}, nil
+				},
+			)
+
+			go imbue.Get[Checker2](context.Background(), container)
+			<-started
+
+			reported := make(chan imbue.HealthReport, 1)
+			go func() {
+				reported <- container.Health(context.Background())
+			}()
+
+			var report imbue.HealthReport
+			Eventually(reported).Should(Receive(&report))
+			Expect(report.Healthy).To(BeTrue())
+			Expect(report.Checks).To(HaveLen(1))
+			Expect(report.Checks[0].Type).To(Equal("imbue_test.Checker1"))
+		})
+
+		It("reports a check that panics as unhealthy", func() {
+			imbue.With0Grouped[ServiceA](
+				container,
+				func(ctx imbue.Context) (Checker1, error) {
+					return Checker1{&testChecker{
+						func(ctx context.Context) error {
+							panic("<panic>")
+						},
+					}}, nil
+				},
+			)
+
+			_, err := imbue.Get[imbue.FromGroup[ServiceA, Checker1]](context.Background(), container)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			report := container.Health(context.Background())
+			Expect(report.Healthy).To(BeFalse())
+			Expect(report.Checks).To(HaveLen(1))
+			Expect(report.Checks[0].Error).To(Equal("panic: <panic>"))
+		})
+
+		It("applies a timeout to each check", func() {
+			imbue.With0Grouped[ServiceA](
+				container,
+				func(ctx imbue.Context) (Checker1, error) {
+					return Checker1{&testChecker{
+						func(ctx context.Context) error {
+							<-ctx.Done()
+							return ctx.Err()
+						},
+					}}, nil
+				},
+			)
+
+			err := imbue.Invoke1(
+				context.Background(),
+				container,
+				func(
+					ctx context.Context,
+					dep imbue.FromGroup[ServiceA, Checker1],
+				) error {
+					return nil
+				},
+			)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			report := container.Health(
+				context.Background(),
+				imbue.CheckTimeout(time.Millisecond),
+			)
+			Expect(report.Healthy).To(BeFalse())
+			Expect(report.Checks).To(HaveLen(1))
+			Expect(report.Checks[0].Group).To(Equal("ServiceA"))
+			Expect(report.Checks[0].Error).To(Equal(context.DeadlineExceeded.Error()))
+		})
+
+		It("applies the timeout to checks that ignore the context", func() {
+			release := make(chan struct{})
+			defer close(release)
+
+			imbue.With0Grouped[ServiceA](
+				container,
+				func(ctx imbue.Context) (Checker1, error) {
+					return Checker1{&testChecker{
+						func(ctx context.Context) error {
+							<-release
+							return nil
+						},
+					}}, nil
+				},
+			)
+
+			_, err := imbue.Get[imbue.FromGroup[ServiceA, Checker1]](context.Background(), container)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			reported := make(chan imbue.HealthReport, 1)
+			go func() {
+				reported <- container.Health(
+					context.Background(),
+					imbue.CheckTimeout(10*time.Millisecond),
+				)
+			}()
+
+			var report imbue.HealthReport
+			Eventually(reported).Should(Receive(&report))
+			Expect(report.Healthy).To(BeFalse())
+			Expect(report.Checks).To(HaveLen(1))
+			Expect(report.Checks[0].Group).To(Equal("ServiceA"))
+			Expect(report.Checks[0].Error).To(Equal(context.DeadlineExceeded.Error()))
+		})
+	})
+
+	Describe("func HealthHandler()", func() {
+		It("serves the health report as JSON", func() {
+			container := imbue.New()
+			defer container.Close()
+
+			imbue.With0(
+				container,
+				func(ctx imbue.Context) (Checker1, error) {
+					return Checker1{&testChecker{
+						func(ctx context.Context) error {
+							return errors.New("<error>")
+						},
+					}}, nil
+				},
+			)
+
+			_, err := imbue.Get[Checker1](context.Background(), container)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			w := httptest.NewRecorder()
+			container.HealthHandler().ServeHTTP(
+				w,
+				httptest.NewRequest(http.MethodGet, "/healthz", nil),
+			)
+
+			Expect(w.Code).To(Equal(http.StatusServiceUnavailable))
+			Expect(w.Header().Get("Content-Type")).To(Equal("application/json"))
+
+			var report imbue.HealthReport
+			err = json.Unmarshal(w.Body.Bytes(), &report)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(report.Healthy).To(BeFalse())
+			Expect(report.Checks).To(HaveLen(1))
+			Expect(report.Checks[0].Error).To(Equal("<error>"))
+		})
+
+		It("serves an empty list of checks if no dependencies have been checked", func() {
+			container := imbue.New()
+			defer container.Close()
+
+			w := httptest.NewRecorder()
+			container.HealthHandler().ServeHTTP(
+				w,
+				httptest.NewRequest(http.MethodGet, "/healthz", nil),
+			)
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+			Expect(w.Body.String()).To(MatchJSON(`{"healthy": true, "checks": []}`))
+		})
+	})
+})
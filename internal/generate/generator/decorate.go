@@ -121,6 +121,13 @@ func generateDecorateFuncBody(depCount int, code *jen.Group) {
 						generateDecoratorFuncBody(depCount, g)
 					})
 
+				code.
+					Line().
+					Qual(pkgPath, "newDecorateOptions").
+					Call(
+						jen.Id("options"),
+					)
+
 				for n := 0; n < depCount; n++ {
 					code.
 						Line().
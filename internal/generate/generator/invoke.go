@@ -60,6 +60,39 @@ func generateInvokeFunc(code *jen.File, depCount int) {
 }
 
 func generateInvokeFuncBody(depCount int, code *jen.Group) {
+	code.
+		If(
+			jen.Err().
+				Op(":=").
+				Add(containerVar()).
+				Dot("invoked").
+				CallFunc(func(g *jen.Group) {
+					g.Line().Id("options")
+
+					for n := 0; n < depCount; n++ {
+						g.
+							Line().
+							Qual(pkgPath, "get").
+							Types(
+								dependencyType(depCount, n),
+							).
+							Call(
+								containerVar(),
+							)
+					}
+
+					g.Line()
+				}),
+			jen.Err().Op("!=").Nil(),
+		).
+		Block(
+			jen.Return(
+				jen.Err(),
+			),
+		)
+
+	code.Line()
+
 	for n := 0; n < depCount; n++ {
 		code.
 			List(
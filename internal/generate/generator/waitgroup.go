@@ -58,12 +58,14 @@ func generateGoFuncBody(depCount int, code *jen.Group) {
 	code.
 		Add(
 			waitGroupVar().
-				Dot("group").
-				Dot("Go").
+				Dot("start").
 				Call(
-					jen.
+					jen.Line().
 						Func().
-						Params().
+						Params(
+							stdContextParam(),
+							jen.Id("options").Index().Qual(pkgPath, "InvokeOption"),
+						).
 						Error().
 						Block(
 							jen.
@@ -71,13 +73,16 @@ func generateGoFuncBody(depCount int, code *jen.Group) {
 									jen.
 										Qual(pkgPath, fmt.Sprintf("Invoke%d", depCount)).
 										Call(
-											waitGroupVar().Dot("ctx"),
+											contextVar(),
 											waitGroupVar().Dot("con"),
 											invokeFuncVar(),
 											jen.Id("options").Op("..."),
 										),
 								),
 						),
+					jen.Line().
+						Id("options"),
+					jen.Line(),
 				),
 		)
 }
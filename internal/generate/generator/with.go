@@ -63,6 +63,24 @@ func generateWithFunc(code *jen.File, depCount int) {
 }
 
 func generateWithFuncBody(depCount int, code *jen.Group) {
+	code.
+		Id("opts").
+		Op(":=").
+		Qual(pkgPath, "newWithOptions").
+		Call(
+			jen.Id("options"),
+		)
+
+	code.
+		If(
+			jen.Op("!").Id("opts").Dot("IsEnabled").Call(containerVar()),
+		).
+		Block(
+			jen.Return(),
+		)
+
+	code.Line()
+
 	code.
 		Add(declaringDeclVar(depCount)).
 		Op(":=").
@@ -108,6 +126,10 @@ func generateWithFuncBody(depCount int, code *jen.Group) {
 						generateConstructorFuncBody(depCount, g)
 					})
 
+				code.
+					Line().
+					Id("opts")
+
 				for n := 0; n < depCount; n++ {
 					code.
 						Line().
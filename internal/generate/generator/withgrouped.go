@@ -111,6 +111,12 @@ func generateWithGroupedFuncBody(depCount int, code *jen.Group) {
 							jen.Err(),
 						),
 				),
+			jen.Line().
+				Qual(pkgPath, "withGroupedOptions").
+				Call(
+					jen.Id("options"),
+				).
+				Op("..."),
 			jen.Line(),
 		)
 }
@@ -114,6 +114,12 @@ func generateWithNamedFuncBody(depCount int, code *jen.Group) {
 							jen.Err(),
 						),
 				),
+			jen.Line().
+				Qual(pkgPath, "withNamedOptions").
+				Call(
+					jen.Id("options"),
+				).
+				Op("..."),
 			jen.Line(),
 		)
 }
@@ -48,3 +48,12 @@ func ToScreamingSnakeCase(camel string) string {
 
 	return result.String()
 }
+
+// ToKebabCase converts a Go identifier to kebab-case.
+func ToKebabCase(camel string) string {
+	return strings.ReplaceAll(
+		strings.ToLower(ToScreamingSnakeCase(camel)),
+		"_",
+		"-",
+	)
+}
@@ -33,3 +33,17 @@ var _ = Describe("func ToScreamingSnakeCase()", func() {
 		Entry("numbers with initialism", "FooBAR123Spam", "FOO_BAR123_SPAM"),
 	)
 })
+
+var _ = Describe("func ToKebabCase()", func() {
+	DescribeTable(
+		"it converts a string to kebab-case",
+		func(camel, expect string) {
+			Expect(ToKebabCase(camel)).To(Equal(expect))
+		},
+		Entry("1-word camel case", "foo", "foo"),
+		Entry("1-word pascal case", "Foo", "foo"),
+		Entry("2-word camel case", "fooBar", "foo-bar"),
+		Entry("2-word initialism at start", "FOOBar", "foo-bar"),
+		Entry("numbers", "FooBar123Spam", "foo-bar123-spam"),
+	)
+})
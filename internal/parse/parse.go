@@ -0,0 +1,61 @@
+// Package parse parses textual values into Go values using reflection.
+package parse
+
+import (
+	"encoding"
+	"errors"
+	"reflect"
+	"strconv"
+	"time"
+)
+
+// textUnmarshalerType is the reflect.Type for encoding.TextUnmarshaler.
+var textUnmarshalerType = reflect.TypeFor[encoding.TextUnmarshaler]()
+
+// IsNested returns true if t is a struct whose fields should be parsed
+// individually, rather than parsing the struct as a single value.
+func IsNested(t reflect.Type) bool {
+	return t.Kind() == reflect.Struct &&
+		!reflect.PointerTo(t).Implements(textUnmarshalerType)
+}
+
+// Value parses s into v, which must be addressable.
+func Value(v reflect.Value, s string) error {
+	if u, ok := v.Addr().Interface().(encoding.TextUnmarshaler); ok {
+		return u.UnmarshalText([]byte(s))
+	}
+
+	if v.Type() == reflect.TypeFor[time.Duration]() {
+		d, err := time.ParseDuration(s)
+		v.SetInt(int64(d))
+		return err
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(s)
+		return nil
+
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		v.SetBool(b)
+		return err
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(s, 0, v.Type().Bits())
+		v.SetInt(n)
+		return err
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(s, 0, v.Type().Bits())
+		v.SetUint(n)
+		return err
+
+	case reflect.Float32, reflect.Float64:
+		n, err := strconv.ParseFloat(s, v.Type().Bits())
+		v.SetFloat(n)
+		return err
+	}
+
+	return errors.New("unsupported type")
+}
@@ -11,6 +11,13 @@ func Invoke1[D any](
 	fn func(context.Context, D) error,
 	options ...InvokeOption,
 ) error {
+	if err := con.invoked(
+		options,
+		get[D](con),
+	); err != nil {
+		return err
+	}
+
 	v1, err := get[D](con).Resolve(ctx)
 	if err != nil {
 		return filterInvokeError(err)
@@ -26,6 +33,14 @@ func Invoke2[D1, D2 any](
 	fn func(context.Context, D1, D2) error,
 	options ...InvokeOption,
 ) error {
+	if err := con.invoked(
+		options,
+		get[D1](con),
+		get[D2](con),
+	); err != nil {
+		return err
+	}
+
 	v1, err := get[D1](con).Resolve(ctx)
 	if err != nil {
 		return filterInvokeError(err)
@@ -46,6 +61,15 @@ func Invoke3[D1, D2, D3 any](
 	fn func(context.Context, D1, D2, D3) error,
 	options ...InvokeOption,
 ) error {
+	if err := con.invoked(
+		options,
+		get[D1](con),
+		get[D2](con),
+		get[D3](con),
+	); err != nil {
+		return err
+	}
+
 	v1, err := get[D1](con).Resolve(ctx)
 	if err != nil {
 		return filterInvokeError(err)
@@ -71,6 +95,16 @@ func Invoke4[D1, D2, D3, D4 any](
 	fn func(context.Context, D1, D2, D3, D4) error,
 	options ...InvokeOption,
 ) error {
+	if err := con.invoked(
+		options,
+		get[D1](con),
+		get[D2](con),
+		get[D3](con),
+		get[D4](con),
+	); err != nil {
+		return err
+	}
+
 	v1, err := get[D1](con).Resolve(ctx)
 	if err != nil {
 		return filterInvokeError(err)
@@ -101,6 +135,17 @@ func Invoke5[D1, D2, D3, D4, D5 any](
 	fn func(context.Context, D1, D2, D3, D4, D5) error,
 	options ...InvokeOption,
 ) error {
+	if err := con.invoked(
+		options,
+		get[D1](con),
+		get[D2](con),
+		get[D3](con),
+		get[D4](con),
+		get[D5](con),
+	); err != nil {
+		return err
+	}
+
 	v1, err := get[D1](con).Resolve(ctx)
 	if err != nil {
 		return filterInvokeError(err)
@@ -136,6 +181,18 @@ func Invoke6[D1, D2, D3, D4, D5, D6 any](
 	fn func(context.Context, D1, D2, D3, D4, D5, D6) error,
 	options ...InvokeOption,
 ) error {
+	if err := con.invoked(
+		options,
+		get[D1](con),
+		get[D2](con),
+		get[D3](con),
+		get[D4](con),
+		get[D5](con),
+		get[D6](con),
+	); err != nil {
+		return err
+	}
+
 	v1, err := get[D1](con).Resolve(ctx)
 	if err != nil {
 		return filterInvokeError(err)
@@ -176,6 +233,19 @@ func Invoke7[D1, D2, D3, D4, D5, D6, D7 any](
 	fn func(context.Context, D1, D2, D3, D4, D5, D6, D7) error,
 	options ...InvokeOption,
 ) error {
+	if err := con.invoked(
+		options,
+		get[D1](con),
+		get[D2](con),
+		get[D3](con),
+		get[D4](con),
+		get[D5](con),
+		get[D6](con),
+		get[D7](con),
+	); err != nil {
+		return err
+	}
+
 	v1, err := get[D1](con).Resolve(ctx)
 	if err != nil {
 		return filterInvokeError(err)
@@ -221,6 +291,20 @@ func Invoke8[D1, D2, D3, D4, D5, D6, D7, D8 any](
 	fn func(context.Context, D1, D2, D3, D4, D5, D6, D7, D8) error,
 	options ...InvokeOption,
 ) error {
+	if err := con.invoked(
+		options,
+		get[D1](con),
+		get[D2](con),
+		get[D3](con),
+		get[D4](con),
+		get[D5](con),
+		get[D6](con),
+		get[D7](con),
+		get[D8](con),
+	); err != nil {
+		return err
+	}
+
 	v1, err := get[D1](con).Resolve(ctx)
 	if err != nil {
 		return filterInvokeError(err)
@@ -3,12 +3,146 @@ package imbue
 import (
 	"context"
 	"errors"
+	"fmt"
+	"sort"
+	"time"
 )
 
-// InvokeOption is an option that changes the behavior of a call to InvokeX().
+// InvokeOption is an option that changes the behavior of a call to InvokeX()
+// or GoX().
 type InvokeOption interface {
-	applyInvokeOptionToContainer(*Container) error
-	applyInvokeOptionToContext(*scopedContext) error
+	applyInvokeOption(*invokeOptions)
+}
+
+// invokeOptions is the set of options that apply to a single call to InvokeX()
+// or GoX().
+type invokeOptions struct {
+	// name is a human-readable name for a function started by GoX(), used to
+	// identify it in error messages.
+	name string
+
+	// restartOnError indicates whether a function started by GoX() is called
+	// again when it returns an error.
+	restartOnError bool
+
+	// maxRestarts is the maximum number of times the function is restarted. A
+	// negative value means there is no limit.
+	maxRestarts int
+
+	// backoff is the delay before each restart.
+	backoff time.Duration
+
+	// isNonCritical indicates whether errors from a function started by GoX()
+	// are ignored by the wait group.
+	isNonCritical bool
+
+	// startedAt is the location of the call to GoX() that started the
+	// function, if any.
+	startedAt *location
+}
+
+// newInvokeOptions returns the invokeOptions described by the given options.
+func newInvokeOptions(options []InvokeOption) invokeOptions {
+	opts := invokeOptions{
+		maxRestarts: -1,
+	}
+
+	for _, opt := range options {
+		opt.applyInvokeOption(&opts)
+	}
+
+	return opts
+}
+
+// startedAt is an InvokeOption that records the location of the call to GoX()
+// that started a function.
+func startedAt(loc location) InvokeOption {
+	return option{
+		forInvoke: func(opts *invokeOptions) {
+			opts.startedAt = &loc
+		},
+	}
+}
+
+// invocation is a call site of InvokeX() or GoX().
+//
+// Invocations form the roots of the dependency graph, as they are the points at
+// which the application actually uses the container.
+type invocation struct {
+	// loc is the location of the call.
+	loc location
+
+	// isGoroutine is true if the call is to GoX(), rather than InvokeX().
+	isGoroutine bool
+
+	// deps are the declarations of the dependencies requested by the call.
+	deps []declaration
+}
+
+// String returns a description of the invocation.
+func (i invocation) String() string {
+	if i.isGoroutine {
+		return fmt.Sprintf("<go> (%s)", i.loc)
+	}
+
+	return fmt.Sprintf("<invoke> (%s)", i.loc)
+}
+
+// invoked records a call to InvokeX() or GoX() that requests the given
+// dependencies.
+//
+// It returns the error from c.Err(), if any, in which case the call must not
+// proceed.
+func (c *Container) invoked(
+	options []InvokeOption,
+	deps ...declaration,
+) error {
+	opts := newInvokeOptions(options)
+
+	inv := invocation{
+		deps: deps,
+	}
+
+	if opts.startedAt != nil {
+		inv.loc = *opts.startedAt
+		inv.isGoroutine = true
+	} else {
+		inv.loc = findLocation()
+	}
+
+	c.m.Lock()
+
+	if c.invocations == nil {
+		c.invocations = map[location]invocation{}
+	}
+
+	c.invocations[inv.loc] = inv
+
+	c.m.Unlock()
+
+	return c.Err()
+}
+
+// sortInvocations returns the given invocations sorted by location.
+func sortInvocations(invocations map[location]invocation) []invocation {
+	sorted := make([]invocation, 0, len(invocations))
+
+	for _, inv := range invocations {
+		sorted = append(sorted, inv)
+	}
+
+	sort.Slice(
+		sorted,
+		func(i, j int) bool {
+			a, b := sorted[i].loc, sorted[j].loc
+			if a.File != b.File {
+				return a.File < b.File
+			}
+			return a.Line < b.Line
+		},
+	)
+
+	return sorted
 }
 
 // filterInvokeError is called when an InvokeX() function is about to return an
@@ -26,13 +160,17 @@ func filterInvokeError(err error) error {
 
 // Invoke0 calls a function without dependencies.
 //
-// This function does not use the container at all; it is included to aid while
-// refactoring.
+// This function does not resolve any dependencies; it is included to aid while
+// refactoring. The call is still recorded as a root of the dependency graph.
 func Invoke0(
 	ctx context.Context,
 	con *Container,
 	fn func(context.Context) error,
 	options ...InvokeOption,
 ) error {
+	if err := con.invoked(options); err != nil {
+		return err
+	}
+
 	return filterInvokeError(fn(ctx))
 }
@@ -2,8 +2,11 @@ package imbue
 
 import (
 	"fmt"
+	"log/slog"
+	"path"
 	"path/filepath"
 	"runtime"
+	"runtime/debug"
 	"strings"
 )
 
@@ -11,6 +14,25 @@ import (
 type location struct {
 	File string
 	Line int
+
+	// Package is the import path of the package that contains the code, if
+	// known.
+	Package string
+}
+
+// TrimmedFile returns the path of the file in the form produced by the
+// -trimpath build flag; that is, the import path of the package followed by
+// the file's name.
+//
+// Unlike File, it does not depend on the location of the source code on the
+// machine that built the application. If the package is unknown, it returns
+// File unchanged.
+func (l location) TrimmedFile() string {
+	if l.Package == "" {
+		return l.File
+	}
+
+	return path.Join(l.Package, filepath.Base(l.File))
 }
 
 func (l location) String() string {
@@ -21,6 +43,14 @@ func (l location) String() string {
 	)
 }
 
+// LogValue returns the location as a structured log value.
+func (l location) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("file", l.File),
+		slog.Int("line", l.Line),
+	)
+}
+
 // findLocation returns the file and line number of the first frame in the
 // current goroutine's stack that is NOT part of the imbue package.
 func findLocation() location {
@@ -35,17 +65,44 @@ func findLocation() location {
 		for {
 			fr, more := iter.Next()
 
-			if !more || !isImbueFrame(fr) {
+			if !isImbueFrame(fr) {
 				return location{
 					fr.File,
 					fr.Line,
+					packageOf(fr.Function),
 				}
 			}
+
+			if !more {
+				break
+			}
 		}
 	}
 }
 
-// isImbueFrame returns true if the given frame is part of the imbue package.
+// packageOf returns the import path of the package that contains the function
+// with the given fully-qualified name, as reported by runtime.Frame.Function.
+//
+// External test packages are reported as the package under test, and the main
+// package is reported using its import path, both of which are consistent with
+// the file paths produced by the -trimpath build flag.
+func packageOf(fn string) string {
+	slash := strings.LastIndexByte(fn, '/')
+	dot := strings.IndexByte(fn[slash+1:], '.')
+	if dot == -1 {
+		return ""
+	}
+
+	pkg := fn[:slash+1+dot]
+	if pkg == "main" {
+		return mainPackage
+	}
+
+	return strings.TrimSuffix(pkg, "_test")
+}
+
+// isImbueFrame returns true if the given frame is part of the imbue package,
+// or one of its sub-packages, such as imbue/config.
 //
 // Note that we cannot simply use the package path that is prefixed to the
 // function name, because as of Go v1.21 it is possible to have a single frame
@@ -54,14 +111,21 @@ func findLocation() location {
 // result we now use the file path to determine what is and isn't part of the
 // imbue package.
 func isImbueFrame(fr runtime.Frame) bool {
-	return imbueDir != "" &&
-		filepath.Dir(fr.File) == imbueDir &&
-		!strings.HasSuffix(fr.File, "_test.go")
+	if imbueDir == "" || strings.HasSuffix(fr.File, "_test.go") {
+		return false
+	}
+
+	dir := filepath.Dir(fr.File)
+	return dir == imbueDir ||
+		strings.HasPrefix(dir, imbueDir+string(filepath.Separator))
 }
 
 // imbueDir is the absolute path to the imbue package directory.
 var imbueDir string
 
+// mainPackage is the import path of the main package, if known.
+var mainPackage string
+
 func init() {
 	var pointers [1]uintptr
 
@@ -72,4 +136,8 @@ func init() {
 	iter := runtime.CallersFrames(pointers[:count])
 	fr, _ := iter.Next()
 	imbueDir = filepath.Dir(fr.File)
+
+	if info, ok := debug.ReadBuildInfo(); ok {
+		mainPackage = info.Path
+	}
 }
@@ -0,0 +1,171 @@
+package imbue
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+// WithLogger is a ContainerOption that causes the container to log its
+// activity to the given logger.
+//
+// Records are logged when constructors and decorators are called, and when
+// functions are deferred or called by Close(). Failures of functions started by
+// GoX() are also logged, including those of non-critical functions.
+//
+// The duration logged for a constructor or decorator includes the time taken to
+// construct any of its dependencies that had not already been constructed. The
+// records for those dependencies are logged between the records for the
+// constructor or decorator itself.
+func WithLogger(l *slog.Logger) ContainerOption {
+	return WithObserver(loggingObserver{l})
+}
+
+// loggingObserver is an Observer that logs container activity to a
+// structured logger.
+type loggingObserver struct {
+	logger *slog.Logger
+}
+
+func (o loggingObserver) ConstructStart(ctx context.Context, t TypeInfo) context.Context {
+	o.logger.LogAttrs(
+		ctx,
+		slog.LevelDebug,
+		"constructing dependency",
+		typeAttrs(t)...,
+	)
+
+	return ctx
+}
+
+func (o loggingObserver) ConstructEnd(ctx context.Context, t TypeInfo, err error, d time.Duration) {
+	if err != nil {
+		o.logger.LogAttrs(
+			ctx,
+			slog.LevelError,
+			"failed to construct dependency",
+			typeAttrs(
+				t,
+				slog.Duration("duration", d),
+				slog.Any("error", err),
+			)...,
+		)
+		return
+	}
+
+	o.logger.LogAttrs(
+		ctx,
+		slog.LevelInfo,
+		"constructed dependency",
+		typeAttrs(
+			t,
+			slog.Duration("duration", d),
+		)...,
+	)
+}
+
+func (o loggingObserver) DecorateStart(ctx context.Context, t TypeInfo) context.Context {
+	o.logger.LogAttrs(
+		ctx,
+		slog.LevelDebug,
+		"decorating dependency",
+		typeAttrs(t)...,
+	)
+
+	return ctx
+}
+
+func (o loggingObserver) DecorateEnd(ctx context.Context, t TypeInfo, err error, d time.Duration) {
+	if err != nil {
+		o.logger.LogAttrs(
+			ctx,
+			slog.LevelError,
+			"failed to decorate dependency",
+			typeAttrs(
+				t,
+				slog.Duration("duration", d),
+				slog.Any("error", err),
+			)...,
+		)
+		return
+	}
+
+	o.logger.LogAttrs(
+		ctx,
+		slog.LevelInfo,
+		"decorated dependency",
+		typeAttrs(
+			t,
+			slog.Duration("duration", d),
+		)...,
+	)
+}
+
+func (o loggingObserver) Deferred(ctx context.Context, t TypeInfo) {
+	o.logger.LogAttrs(
+		ctx,
+		slog.LevelDebug,
+		"deferred function",
+		typeAttrs(t)...,
+	)
+}
+
+func (o loggingObserver) DeferCalled(t TypeInfo, err error) {
+	if err != nil {
+		o.logger.LogAttrs(
+			context.Background(),
+			slog.LevelError,
+			"deferred function failed",
+			typeAttrs(
+				t,
+				slog.Any("error", err),
+			)...,
+		)
+		return
+	}
+
+	o.logger.LogAttrs(
+		context.Background(),
+		slog.LevelDebug,
+		"called deferred function",
+		typeAttrs(t)...,
+	)
+}
+
+func (o loggingObserver) GoroutineFailed(ctx context.Context, err error) {
+	o.logger.LogAttrs(
+		ctx,
+		slog.LevelError,
+		"goroutine failed",
+		slog.Any("error", err),
+	)
+}
+
+func (o loggingObserver) Closed(err error) {
+	if err != nil {
+		o.logger.LogAttrs(
+			context.Background(),
+			slog.LevelError,
+			"failed to close container",
+			slog.Any("error", err),
+		)
+		return
+	}
+
+	o.logger.LogAttrs(
+		context.Background(),
+		slog.LevelDebug,
+		"closed container",
+	)
+}
+
+// typeAttrs returns the log attributes that describe t, followed by attrs.
+func typeAttrs(t TypeInfo, attrs ...slog.Attr) []slog.Attr {
+	return append(
+		[]slog.Attr{
+			slog.String("type", t.Type.String()),
+			slog.Any("location", location{File: t.File, Line: t.Line}),
+		},
+		attrs...,
+	)
+}
@@ -0,0 +1,73 @@
+package imbue_test
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"strings"
+
+	"github.com/dogmatiq/imbue"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("func WithLogger()", func() {
+	It("logs the container's activity", func() {
+		buf := &bytes.Buffer{}
+		logger := slog.New(
+			slog.NewTextHandler(
+				buf,
+				&slog.HandlerOptions{
+					Level: slog.LevelDebug,
+					ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+						switch a.Key {
+						case slog.TimeKey, "duration", "file":
+							return slog.Attr{}
+						}
+						return a
+					},
+				},
+			),
+		)
+
+		container := imbue.New(imbue.WithLogger(logger))
+
+		imbue.With0(
+			container,
+			func(ctx imbue.Context) (Concrete1, error) {
+				ctx.Defer(func() error { return nil })
+				return "<concrete>", nil
+			},
+		)
+
+		imbue.Decorate0(
+			container,
+			func(ctx imbue.Context, v Concrete1) (Concrete1, error) {
+				return v, nil
+			},
+		)
+
+		err := imbue.Invoke1(
+			context.Background(),
+			container,
+			func(ctx context.Context, dep Concrete1) error {
+				return nil
+			},
+		)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		err = container.Close()
+		Expect(err).ShouldNot(HaveOccurred())
+
+		lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+		Expect(lines).To(HaveExactElements(
+			MatchRegexp(`^level=DEBUG msg="constructing dependency" type=imbue_test\.Concrete1 location\.line=\d+$`),
+			MatchRegexp(`^level=DEBUG msg="deferred function" type=imbue_test\.Concrete1 location\.line=\d+$`),
+			MatchRegexp(`^level=INFO msg="constructed dependency" type=imbue_test\.Concrete1 location\.line=\d+$`),
+			MatchRegexp(`^level=DEBUG msg="decorating dependency" type=imbue_test\.Concrete1 location\.line=\d+$`),
+			MatchRegexp(`^level=INFO msg="decorated dependency" type=imbue_test\.Concrete1 location\.line=\d+$`),
+			MatchRegexp(`^level=DEBUG msg="called deferred function" type=imbue_test\.Concrete1 location\.line=\d+$`),
+			MatchRegexp(`^level=DEBUG msg="closed container"$`),
+		))
+	})
+})
@@ -0,0 +1,103 @@
+package imbue
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+)
+
+// WriteMermaid writes a Mermaid "graph TD" diagram of the container's
+// dependencies to w.
+//
+// Each declaration is rendered as a node labelled with its type. Named and
+// grouped dependencies include the name or group in the label. Nodes are
+// styled according to whether their values have been constructed. Calls to
+// InvokeX() and GoX() are rendered as hexagonal nodes labelled with their
+// location.
+func (c *Container) WriteMermaid(w io.Writer) error {
+	g := c.Graph()
+
+	buf := bufio.NewWriter(w)
+	buf.WriteString("graph TD\n")
+
+	ids := map[string]string{}
+	var constructed, unconstructed []string
+
+	for i, n := range g.Nodes {
+		id := fmt.Sprintf("n%d", i)
+		ids[n.ID] = id
+
+		open, close := "[", "]"
+		if n.Implicit {
+			open, close = "([", "])"
+		}
+
+		fmt.Fprintf(
+			buf,
+			"    %s%s\"%s\"%s\n",
+			id,
+			open,
+			mermaidLabel(n),
+			close,
+		)
+
+		if n.Constructed {
+			constructed = append(constructed, id)
+		} else {
+			unconstructed = append(unconstructed, id)
+		}
+	}
+
+	for i, r := range g.Roots {
+		id := fmt.Sprintf("r%d", i)
+		ids[r.ID] = id
+
+		fmt.Fprintf(
+			buf,
+			"    %s{{\"%s %s:%d\"}}\n",
+			id,
+			r.Kind,
+			filepath.Base(r.Location.File),
+			r.Location.Line,
+		)
+	}
+
+	for _, e := range g.Edges {
+		fmt.Fprintf(
+			buf,
+			"    %s --> %s\n",
+			ids[e.From],
+			ids[e.To],
+		)
+	}
+
+	buf.WriteString("    classDef constructed stroke-width:2px\n")
+	buf.WriteString("    classDef unconstructed stroke-dasharray:5 5\n")
+
+	if len(constructed) != 0 {
+		fmt.Fprintf(buf, "    class %s constructed\n", strings.Join(constructed, ","))
+	}
+
+	if len(unconstructed) != 0 {
+		fmt.Fprintf(buf, "    class %s unconstructed\n", strings.Join(unconstructed, ","))
+	}
+
+	return buf.Flush()
+}
+
+// mermaidLabel returns the label to use for n within a Mermaid diagram.
+func mermaidLabel(n GraphNode) string {
+	label := n.Type
+
+	if n.Name != "" {
+		label += "<br/>name: " + n.Name
+	}
+
+	if n.Group != "" {
+		label += "<br/>group: " + n.Group
+	}
+
+	return strings.ReplaceAll(label, `"`, "#quot;")
+}
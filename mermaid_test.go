@@ -0,0 +1,82 @@
+package imbue_test
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"strings"
+
+	"github.com/dogmatiq/imbue"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("type Container", func() {
+	Describe("func WriteMermaid()", func() {
+		It("writes a diagram of the dependencies", func() {
+			container := imbue.New()
+			defer container.Close()
+
+			imbue.With0(
+				container,
+				func(ctx imbue.Context) (Concrete1, error) {
+					return "<concrete>", nil
+				},
+			)
+
+			imbue.With1(
+				container,
+				func(ctx imbue.Context, dep imbue.Optional[Concrete1]) (Concrete2, error) {
+					return "<concrete>", nil
+				},
+			)
+
+			imbue.With1Named[Foreground](
+				container,
+				func(ctx imbue.Context, dep Concrete1) (Color, error) {
+					return "<color>", nil
+				},
+			)
+
+			imbue.With0Grouped[ServiceA](
+				container,
+				func(ctx imbue.Context) (Concrete3, error) {
+					return "<concrete>", nil
+				},
+			)
+
+			_, _, line, _ := runtime.Caller(0)
+			err := imbue.Invoke1(
+				context.Background(),
+				container,
+				func(ctx context.Context, dep Concrete1) error {
+					return nil
+				},
+			)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			var w strings.Builder
+			err = container.WriteMermaid(&w)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			Expect(w.String()).To(Equal(fmt.Sprintf(`graph TD
+    n0["imbue_test.Color<br/>name: Foreground"]
+    n1["imbue_test.Concrete3<br/>group: ServiceA"]
+    n2(["imbue.Optional[github.com/dogmatiq/imbue_test.Concrete1]"])
+    n3["imbue_test.Concrete1"]
+    n4["imbue_test.Concrete2"]
+    r0{{"invoke mermaid_test.go:%d"}}
+    n0 --> n3
+    n2 --> n3
+    n4 --> n2
+    r0 --> n3
+    classDef constructed stroke-width:2px
+    classDef unconstructed stroke-dasharray:5 5
+    class n3 constructed
+    class n0,n1,n2,n4 unconstructed
+`,
+				line+1,
+			)))
+		})
+	})
+})
@@ -0,0 +1,192 @@
+package imbue
+
+import (
+	"context"
+	"reflect"
+	"time"
+)
+
+// Observer is an interface for observing the activity of a container.
+//
+// Observers are registered with a container using the WithObserver() option.
+// Implementations should embed NoopObserver so that they continue to compile if
+// methods are added to this interface.
+type Observer interface {
+	// ConstructStart is called before a constructor is called.
+	//
+	// The returned context is passed to the constructor, and to the subsequent
+	// call to ConstructEnd().
+	ConstructStart(ctx context.Context, t TypeInfo) context.Context
+
+	// ConstructEnd is called after a constructor returns.
+	//
+	// err is the error returned by the constructor, if any. d is the time taken
+	// by the constructor, including the time taken to construct any of its
+	// dependencies that had not already been constructed. Calls for those
+	// dependencies are nested between this call and the matching call to
+	// ConstructStart().
+	ConstructEnd(ctx context.Context, t TypeInfo, err error, d time.Duration)
+
+	// DecorateStart is called before a decorator is called.
+	//
+	// The returned context is passed to the decorator, and to the subsequent
+	// call to DecorateEnd().
+	DecorateStart(ctx context.Context, t TypeInfo) context.Context
+
+	// DecorateEnd is called after a decorator returns.
+	//
+	// err is the error returned by the decorator, if any. d is the time taken by
+	// the decorator, including the time taken to construct any of its
+	// dependencies that had not already been constructed.
+	DecorateEnd(ctx context.Context, t TypeInfo, err error, d time.Duration)
+
+	// Deferred is called when a constructor or decorator defers a function.
+	//
+	// t describes the location of the call to Context.Defer().
+	Deferred(ctx context.Context, t TypeInfo)
+
+	// DeferCalled is called after a deferred function is called.
+	//
+	// t describes the location of the call to Context.Defer(). err is the error
+	// returned by the deferred function, if any.
+	DeferCalled(t TypeInfo, err error)
+
+	// GoroutineFailed is called when a function started by GoX() fails, and
+	// will not be restarted.
+	//
+	// It is called for all such failures, including those of functions started
+	// with the NonCritical() option, which are not otherwise reported. err
+	// describes the failure, including the location of the call to GoX().
+	GoroutineFailed(ctx context.Context, err error)
+
+	// Closed is called after the container is closed.
+	//
+	// err is the error returned by Container.Close(), if any.
+	Closed(err error)
+}
+
+// TypeInfo describes a dependency type and the user-supplied code that is
+// operating on it.
+type TypeInfo struct {
+	// Type is the type of the dependency.
+	Type reflect.Type
+
+	// File and Line describe the location of the code that is operating on the
+	// dependency.
+	//
+	// For constructors and decorators this is the location of the call to
+	// WithX() or DecorateX(). For deferred functions it is the location of the
+	// call to Context.Defer().
+	File string
+	Line int
+}
+
+// typeInfoOf returns the TypeInfo for the given user function.
+func typeInfoOf(fn userFunction) TypeInfo {
+	loc := fn.Location()
+
+	return TypeInfo{
+		Type: fn.Type(),
+		File: loc.File,
+		Line: loc.Line,
+	}
+}
+
+// NoopObserver is an Observer that does nothing.
+//
+// It is intended to be embedded in other Observer implementations.
+type NoopObserver struct{}
+
+// ConstructStart returns ctx unchanged.
+func (NoopObserver) ConstructStart(ctx context.Context, _ TypeInfo) context.Context {
+	return ctx
+}
+
+// ConstructEnd does nothing.
+func (NoopObserver) ConstructEnd(context.Context, TypeInfo, error, time.Duration) {}
+
+// DecorateStart returns ctx unchanged.
+func (NoopObserver) DecorateStart(ctx context.Context, _ TypeInfo) context.Context {
+	return ctx
+}
+
+// DecorateEnd does nothing.
+func (NoopObserver) DecorateEnd(context.Context, TypeInfo, error, time.Duration) {}
+
+// Deferred does nothing.
+func (NoopObserver) Deferred(context.Context, TypeInfo) {}
+
+// DeferCalled does nothing.
+func (NoopObserver) DeferCalled(TypeInfo, error) {}
+
+// GoroutineFailed does nothing.
+func (NoopObserver) GoroutineFailed(context.Context, error) {}
+
+// Closed does nothing.
+func (NoopObserver) Closed(error) {}
+
+// WithObserver is a ContainerOption that registers an observer with the
+// container.
+//
+// Multiple observers may be registered. They are notified in the order they are
+// registered.
+func WithObserver(o Observer) ContainerOption {
+	return option{
+		forContainer: func(con *Container) {
+			con.observers = append(con.observers, o)
+		},
+	}
+}
+
+// observerSet is an Observer that notifies multiple observers.
+type observerSet []Observer
+
+func (s observerSet) ConstructStart(ctx context.Context, t TypeInfo) context.Context {
+	for _, o := range s {
+		ctx = o.ConstructStart(ctx, t)
+	}
+	return ctx
+}
+
+func (s observerSet) ConstructEnd(ctx context.Context, t TypeInfo, err error, d time.Duration) {
+	for _, o := range s {
+		o.ConstructEnd(ctx, t, err, d)
+	}
+}
+
+func (s observerSet) DecorateStart(ctx context.Context, t TypeInfo) context.Context {
+	for _, o := range s {
+		ctx = o.DecorateStart(ctx, t)
+	}
+	return ctx
+}
+
+func (s observerSet) DecorateEnd(ctx context.Context, t TypeInfo, err error, d time.Duration) {
+	for _, o := range s {
+		o.DecorateEnd(ctx, t, err, d)
+	}
+}
+
+func (s observerSet) Deferred(ctx context.Context, t TypeInfo) {
+	for _, o := range s {
+		o.Deferred(ctx, t)
+	}
+}
+
+func (s observerSet) DeferCalled(t TypeInfo, err error) {
+	for _, o := range s {
+		o.DeferCalled(t, err)
+	}
+}
+
+func (s observerSet) GoroutineFailed(ctx context.Context, err error) {
+	for _, o := range s {
+		o.GoroutineFailed(ctx, err)
+	}
+}
+
+func (s observerSet) Closed(err error) {
+	for _, o := range s {
+		o.Closed(err)
+	}
+}
@@ -0,0 +1,140 @@
+package imbue_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/dogmatiq/imbue"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// recordingObserver is an Observer that records the events it observes.
+type recordingObserver struct {
+	imbue.NoopObserver
+	events []string
+}
+
+func (o *recordingObserver) ConstructStart(ctx context.Context, t imbue.TypeInfo) context.Context {
+	o.events = append(o.events, fmt.Sprintf("construct start: %s", t.Type))
+	return ctx
+}
+
+func (o *recordingObserver) ConstructEnd(ctx context.Context, t imbue.TypeInfo, err error, d time.Duration) {
+	o.events = append(o.events, fmt.Sprintf("construct end: %s (%v)", t.Type, err))
+}
+
+func (o *recordingObserver) DecorateStart(ctx context.Context, t imbue.TypeInfo) context.Context {
+	o.events = append(o.events, fmt.Sprintf("decorate start: %s", t.Type))
+	return ctx
+}
+
+func (o *recordingObserver) DecorateEnd(ctx context.Context, t imbue.TypeInfo, err error, d time.Duration) {
+	o.events = append(o.events, fmt.Sprintf("decorate end: %s (%v)", t.Type, err))
+}
+
+func (o *recordingObserver) Deferred(ctx context.Context, t imbue.TypeInfo) {
+	o.events = append(o.events, fmt.Sprintf("deferred: %s", t.Type))
+}
+
+func (o *recordingObserver) DeferCalled(t imbue.TypeInfo, err error) {
+	o.events = append(o.events, fmt.Sprintf("defer called: %s (%v)", t.Type, err))
+}
+
+func (o *recordingObserver) GoroutineFailed(ctx context.Context, err error) {
+	o.events = append(o.events, fmt.Sprintf("goroutine failed: %v", err))
+}
+
+func (o *recordingObserver) Closed(err error) {
+	o.events = append(o.events, "closed")
+}
+
+var _ = Describe("func WithObserver()", func() {
+	It("notifies the observer of the container's activity", func() {
+		obs := &recordingObserver{}
+		container := imbue.New(imbue.WithObserver(obs))
+
+		imbue.With0(
+			container,
+			func(ctx imbue.Context) (Concrete1, error) {
+				ctx.Defer(func() error { return errors.New("<error>") })
+				return "<concrete-1>", nil
+			},
+		)
+
+		imbue.With1(
+			container,
+			func(ctx imbue.Context, dep Concrete1) (Concrete2, error) {
+				return "<concrete-2>", nil
+			},
+		)
+
+		imbue.Decorate0(
+			container,
+			func(ctx imbue.Context, v Concrete2) (Concrete2, error) {
+				return v, nil
+			},
+		)
+
+		err := imbue.Invoke1(
+			context.Background(),
+			container,
+			func(ctx context.Context, dep Concrete2) error {
+				return nil
+			},
+		)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		container.Close()
+
+		Expect(obs.events).To(Equal([]string{
+			"construct start: imbue_test.Concrete2",
+			"construct start: imbue_test.Concrete1",
+			"deferred: imbue_test.Concrete1",
+			"construct end: imbue_test.Concrete1 (<nil>)",
+			"construct end: imbue_test.Concrete2 (<nil>)",
+			"decorate start: imbue_test.Concrete2",
+			"decorate end: imbue_test.Concrete2 (<nil>)",
+			"defer called: imbue_test.Concrete1 (<error>)",
+			"closed",
+		}))
+	})
+
+	It("passes the context returned by the observer to the constructor", func() {
+		type key struct{}
+
+		obs := &contextObserver{key: key{}}
+		container := imbue.New(imbue.WithObserver(obs))
+		defer container.Close()
+
+		imbue.With0(
+			container,
+			func(ctx imbue.Context) (Concrete1, error) {
+				Expect(ctx.Value(key{})).To(Equal("<value>"))
+				return "<concrete>", nil
+			},
+		)
+
+		err := imbue.Invoke1(
+			context.Background(),
+			container,
+			func(ctx context.Context, dep Concrete1) error {
+				return nil
+			},
+		)
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+})
+
+// contextObserver is an Observer that adds a value to the context passed to
+// constructors.
+type contextObserver struct {
+	imbue.NoopObserver
+	key any
+}
+
+func (o *contextObserver) ConstructStart(ctx context.Context, t imbue.TypeInfo) context.Context {
+	return context.WithValue(ctx, o.key, "<value>")
+}
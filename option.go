@@ -3,6 +3,11 @@ package imbue
 // option is an implementation of all of the option interfaces.
 type option struct {
 	forContainer func(*Container)
+	forWith      func(*withOptions)
+	forDecorate  func(*decorateOptions)
+	forInvoke    func(*invokeOptions)
+	forRun       func(*runOptions)
+	forHealth    func(*healthOptions)
 }
 
 func (o option) applyContainerOption(con *Container) {
@@ -10,3 +15,41 @@ func (o option) applyContainerOption(con *Container) {
 		o.forContainer(con)
 	}
 }
+
+func (o option) applyWithOption(opts *withOptions) {
+	if o.forWith != nil {
+		o.forWith(opts)
+	}
+}
+
+func (o option) applyWithNamedOption(opts *withOptions) {
+	o.applyWithOption(opts)
+}
+
+func (o option) applyWithGroupedOption(opts *withOptions) {
+	o.applyWithOption(opts)
+}
+
+func (o option) applyDecorateOption(opts *decorateOptions) {
+	if o.forDecorate != nil {
+		o.forDecorate(opts)
+	}
+}
+
+func (o option) applyInvokeOption(opts *invokeOptions) {
+	if o.forInvoke != nil {
+		o.forInvoke(opts)
+	}
+}
+
+func (o option) applyRunOption(opts *runOptions) {
+	if o.forRun != nil {
+		o.forRun(opts)
+	}
+}
+
+func (o option) applyHealthOption(opts *healthOptions) {
+	if o.forHealth != nil {
+		o.forHealth(opts)
+	}
+}
@@ -26,6 +26,7 @@ func (Optional[T]) declare(
 			v, err := dep.Resolve(ctx)
 			return Optional[T]{v, err}, nil
 		},
+		withOptions{},
 		dep,
 	)
 }
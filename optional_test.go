@@ -97,7 +97,7 @@ var _ = Describe("type Optional", func() {
 		}).To(
 			PanicWith(
 				MatchRegexp(
-					`imbue_test\.Concrete1 constructor \(optional_test\.go:\d+\) depends on itself`,
+					`imbue_test\.Concrete1 constructor \(optional_test\.go:\d+\) depends on itself via parameter 2$`,
 				),
 			),
 		)
@@ -138,9 +138,9 @@ var _ = Describe("type Optional", func() {
 			PanicWith(
 				MatchRegexp(
 					`imbue_test\.Concrete3 constructor \(optional_test\.go:\d+\) introduces a cyclic dependency:` +
-						`\n\t-> imbue_test\.Concrete2 \(optional_test\.go:\d+\)` +
-						`\n\t-> imbue_test\.Concrete1 \(optional_test\.go:\d+\)` +
-						`\n\t-> imbue_test\.Concrete3 \(optional_test\.go:\d+\)`,
+						`\n\t-> imbue_test\.Concrete2 \(optional_test\.go:\d+\), via parameter 2 \(imbue_test\.Concrete2\) of imbue_test\.Concrete3 constructor \(optional_test\.go:\d+\)` +
+						`\n\t-> imbue_test\.Concrete1 \(optional_test\.go:\d+\), via parameter 2 \(imbue\.Optional\[github\.com/dogmatiq/imbue_test\.Concrete1\]\) of imbue_test\.Concrete2 constructor \(optional_test\.go:\d+\)` +
+						`\n\t-> imbue_test\.Concrete3 \(optional_test\.go:\d+\), via parameter 2 \(imbue_test\.Concrete3\) of imbue_test\.Concrete1 constructor \(optional_test\.go:\d+\)$`,
 				),
 			),
 		)
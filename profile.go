@@ -0,0 +1,56 @@
+package imbue
+
+// ActiveProfiles is a ContainerOption that activates the given profiles.
+//
+// Declarations made with the Profile() option are only added to the container
+// if their profile is active.
+func ActiveProfiles(profiles ...string) ContainerOption {
+	return option{
+		forContainer: func(con *Container) {
+			if con.profiles == nil {
+				con.profiles = map[string]struct{}{}
+			}
+
+			for _, p := range profiles {
+				con.profiles[p] = struct{}{}
+			}
+		},
+	}
+}
+
+// Profile is a DeclarationOption that causes the declaration to be ignored
+// unless the container has the given profile active.
+//
+// Profiles are activated using the ActiveProfiles() option.
+func Profile(p string) DeclarationOption {
+	return option{
+		forWith: func(opts *withOptions) {
+			opts.conditions = append(
+				opts.conditions,
+				func(con *Container) bool {
+					_, ok := con.profiles[p]
+					return ok
+				},
+			)
+		},
+	}
+}
+
+// When is a DeclarationOption that causes the declaration to be ignored unless
+// pred returns true.
+//
+// pred is called when the declaration is added to the container. When the
+// declaration is made within a Catalog, this is when the catalog is applied to
+// the container, not when the declaration is added to the catalog.
+func When(pred func() bool) DeclarationOption {
+	return option{
+		forWith: func(opts *withOptions) {
+			opts.conditions = append(
+				opts.conditions,
+				func(*Container) bool {
+					return pred()
+				},
+			)
+		},
+	}
+}
@@ -0,0 +1,159 @@
+package imbue_test
+
+import (
+	"context"
+
+	"github.com/dogmatiq/imbue"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("func Profile()", func() {
+	It("adds the declaration when the profile is active", func() {
+		container := imbue.New(imbue.ActiveProfiles("<profile>"))
+		defer container.Close()
+
+		imbue.With0(
+			container,
+			func(ctx imbue.Context) (Concrete1, error) {
+				return "<concrete>", nil
+			},
+			imbue.Profile("<profile>"),
+		)
+
+		err := imbue.Invoke1(
+			context.Background(),
+			container,
+			func(
+				ctx context.Context,
+				dep Concrete1,
+			) error {
+				Expect(dep).To(Equal(Concrete1("<concrete>")))
+				return nil
+			},
+		)
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+
+	It("ignores the declaration when the profile is not active", func() {
+		container := imbue.New(imbue.ActiveProfiles("<other>"))
+		defer container.Close()
+
+		imbue.With0(
+			container,
+			func(ctx imbue.Context) (Concrete1, error) {
+				return "<production>", nil
+			},
+			imbue.Profile("<profile>"),
+		)
+
+		imbue.With0(
+			container,
+			func(ctx imbue.Context) (Concrete1, error) {
+				return "<development>", nil
+			},
+			imbue.Profile("<other>"),
+		)
+
+		err := imbue.Invoke1(
+			context.Background(),
+			container,
+			func(
+				ctx context.Context,
+				dep Concrete1,
+			) error {
+				Expect(dep).To(Equal(Concrete1("<development>")))
+				return nil
+			},
+		)
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+
+	It("evaluates catalog declarations against the profiles of the container", func() {
+		cat := imbue.NewCatalog()
+
+		imbue.With0Named[Foreground](
+			cat,
+			func(ctx imbue.Context) (Color, error) {
+				return "<red>", nil
+			},
+			imbue.Profile("<profile>"),
+		)
+
+		container := imbue.New(
+			imbue.WithCatalog(cat),
+			imbue.ActiveProfiles("<profile>"),
+		)
+		defer container.Close()
+
+		err := imbue.Invoke1(
+			context.Background(),
+			container,
+			func(
+				ctx context.Context,
+				dep imbue.ByName[Foreground, Color],
+			) error {
+				Expect(dep.Value()).To(Equal(Color("<red>")))
+				return nil
+			},
+		)
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+
+	It("does not add the declaration to the dependency tree when the profile is not active", func() {
+		container := imbue.New()
+		defer container.Close()
+
+		imbue.With1(
+			container,
+			func(
+				ctx imbue.Context,
+				dep Concrete2,
+			) (Concrete1, error) {
+				panic("not implemented")
+			},
+			imbue.Profile("<profile>"),
+		)
+
+		expectMultilineString(
+			container,
+			"<container>",
+		)
+	})
+})
+
+var _ = Describe("func When()", func() {
+	It("adds the declaration only if the predicate returns true", func() {
+		container := imbue.New()
+		defer container.Close()
+
+		imbue.With0Grouped[ServiceA](
+			container,
+			func(ctx imbue.Context) (Color, error) {
+				return "<ignored>", nil
+			},
+			imbue.When(func() bool { return false }),
+		)
+
+		imbue.With0Grouped[ServiceA](
+			container,
+			func(ctx imbue.Context) (Color, error) {
+				return "<added>", nil
+			},
+			imbue.When(func() bool { return true }),
+		)
+
+		err := imbue.Invoke1(
+			context.Background(),
+			container,
+			func(
+				ctx context.Context,
+				dep imbue.FromGroup[ServiceA, Color],
+			) error {
+				Expect(dep.Value()).To(Equal(Color("<added>")))
+				return nil
+			},
+		)
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+})
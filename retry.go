@@ -0,0 +1,108 @@
+package imbue
+
+import (
+	"context"
+	"math"
+	"time"
+)
+
+// RetryPolicy describes how a failing constructor is retried.
+type RetryPolicy struct {
+	// Attempts is the maximum number of times to call the constructor,
+	// including the first call. Values less than 1 are treated as 1.
+	Attempts int
+
+	// Backoff is the delay before the second attempt. The delay doubles with
+	// each subsequent attempt.
+	Backoff time.Duration
+
+	// MaxBackoff is the maximum delay between attempts. A value of zero means
+	// there is no limit.
+	MaxBackoff time.Duration
+
+	// ShouldRetry is a predicate that returns true if construction should be
+	// retried after it fails with the given error. If it is nil, construction
+	// is retried after any error.
+	ShouldRetry func(error) bool
+}
+
+// Retry is a DeclarationOption that causes construction of the value to be
+// retried if it fails.
+//
+// Each attempt calls the constructor and any decorators. Functions deferred
+// during a failed attempt are called before the next attempt begins, such that
+// only the functions deferred by the successful attempt are called when the
+// container is closed. If the Timeout() option is also used, the timeout
+// applies to each attempt separately, and an attempt does not call the
+// constructor until any previous attempt that timed out has returned.
+//
+// Construction is not retried if the context is canceled or if the
+// constructor panics. If every attempt fails, the error from the last attempt
+// is returned.
+func Retry(p RetryPolicy) DeclarationOption {
+	return option{
+		forWith: func(opts *withOptions) {
+			opts.retry = p
+		},
+	}
+}
+
+// isRetryable returns true if construction should be retried after the
+// given attempt failed with err.
+func (p RetryPolicy) isRetryable(attempt int, err error) bool {
+	if attempt >= p.Attempts {
+		return false
+	}
+
+	return p.ShouldRetry == nil || p.ShouldRetry(err)
+}
+
+// delay returns the delay to wait before the attempt that follows the given
+// attempt.
+func (p RetryPolicy) delay(attempt int) time.Duration {
+	d := max(p.Backoff, 0)
+
+	for i := 1; i < attempt; i++ {
+		if d > math.MaxInt64/2 {
+			break
+		}
+
+		d *= 2
+	}
+
+	if p.MaxBackoff > 0 {
+		d = min(d, p.MaxBackoff)
+	}
+
+	return d
+}
+
+// constructWithRetry calls the constructor and decorators to construct a new
+// value, retrying according to d.retry if construction fails.
+//
+// Functions deferred by failed attempts are called before the next attempt.
+// Those deferred by the final attempt are added to defers.
+func (d *declarationOf[T]) constructWithRetry(
+	ctx context.Context,
+	defers *deferSet,
+) (T, error) {
+	for attempt := 1; ; attempt++ {
+		v, err := d.constructOnce(ctx, defers)
+		if err == nil || !d.retry.isRetryable(attempt, err) || ctx.Err() != nil {
+			return v, err
+		}
+
+		// Discard the functions deferred by the failed attempt so that they
+		// are not accumulated by the attempts that follow it.
+		defers.Call(d.observer)
+
+		timer := time.NewTimer(d.retry.delay(attempt))
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return v, err
+		case <-timer.C:
+		}
+	}
+}
@@ -0,0 +1,277 @@
+package imbue_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/dogmatiq/imbue"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("func Retry()", func() {
+	var container *imbue.Container
+
+	BeforeEach(func() {
+		container = imbue.New()
+	})
+
+	AfterEach(func() {
+		container.Close()
+	})
+
+	It("retries the constructor until it succeeds", func() {
+		attempt := 0
+
+		imbue.With0(
+			container,
+			func(ctx imbue.Context) (Concrete1, error) {
+				attempt++
+				if attempt < 3 {
+					return "", errors.New("<error>")
+				}
+				return "<concrete>", nil
+			},
+			imbue.Retry(imbue.RetryPolicy{Attempts: 3}),
+		)
+
+		v, err := imbue.Get[Concrete1](context.Background(), container)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(v).To(Equal(Concrete1("<concrete>")))
+		Expect(attempt).To(Equal(3))
+	})
+
+	It("returns the error from the last attempt if every attempt fails", func() {
+		attempt := 0
+
+		imbue.With0(
+			container,
+			func(ctx imbue.Context) (Concrete1, error) {
+				attempt++
+				return "", errors.New("<error>")
+			},
+			imbue.Retry(imbue.RetryPolicy{Attempts: 3}),
+		)
+
+		_, err := imbue.Get[Concrete1](context.Background(), container)
+		Expect(err).To(MatchError(MatchRegexp(
+			`^imbue_test\.Concrete1 constructor \(retry_test\.go:\d+\) failed: <error>$`,
+		)))
+		Expect(attempt).To(Equal(3))
+	})
+
+	It("does not retry if the predicate returns false", func() {
+		retryable := errors.New("<retryable>")
+		attempt := 0
+
+		imbue.With0(
+			container,
+			func(ctx imbue.Context) (Concrete1, error) {
+				attempt++
+				if attempt == 1 {
+					return "", retryable
+				}
+				return "", errors.New("<permanent>")
+			},
+			imbue.Retry(imbue.RetryPolicy{
+				Attempts: 5,
+				ShouldRetry: func(err error) bool {
+					return errors.Is(err, retryable)
+				},
+			}),
+		)
+
+		_, err := imbue.Get[Concrete1](context.Background(), container)
+		Expect(err).To(MatchError(ContainSubstring("<permanent>")))
+		Expect(attempt).To(Equal(2))
+	})
+
+	It("calls the functions deferred by failed attempts before retrying", func() {
+		var calls []string
+		attempt := 0
+
+		imbue.With0(
+			container,
+			func(ctx imbue.Context) (Concrete1, error) {
+				attempt++
+				n := attempt
+
+				ctx.Defer(func() error {
+					calls = append(calls, fmt.Sprintf("<attempt %d>", n))
+					return nil
+				})
+
+				if n == 1 {
+					return "", errors.New("<error>")
+				}
+
+				Expect(calls).To(Equal([]string{"<attempt 1>"}))
+				return "<concrete>", nil
+			},
+			imbue.Retry(imbue.RetryPolicy{Attempts: 2}),
+		)
+
+		_, err := imbue.Get[Concrete1](context.Background(), container)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(calls).To(Equal([]string{"<attempt 1>"}))
+
+		err = container.Close()
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(calls).To(Equal([]string{"<attempt 1>", "<attempt 2>"}))
+	})
+
+	It("retries if a decorator fails", func() {
+		attempt := 0
+
+		imbue.With0(
+			container,
+			func(ctx imbue.Context) (Concrete1, error) {
+				return "<concrete>", nil
+			},
+			imbue.Retry(imbue.RetryPolicy{Attempts: 2}),
+		)
+
+		imbue.Decorate0(
+			container,
+			func(ctx imbue.Context, v Concrete1) (Concrete1, error) {
+				attempt++
+				if attempt == 1 {
+					return "", errors.New("<error>")
+				}
+				return v + "<decorated>", nil
+			},
+		)
+
+		v, err := imbue.Get[Concrete1](context.Background(), container)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(v).To(Equal(Concrete1("<concrete><decorated>")))
+	})
+
+	It("waits an exponentially increasing delay between attempts", func() {
+		var times []time.Time
+
+		imbue.With0(
+			container,
+			func(ctx imbue.Context) (Concrete1, error) {
+				times = append(times, time.Now())
+				return "", errors.New("<error>")
+			},
+			imbue.Retry(imbue.RetryPolicy{
+				Attempts: 3,
+				Backoff:  10 * time.Millisecond,
+			}),
+		)
+
+		_, err := imbue.Get[Concrete1](context.Background(), container)
+		Expect(err).Should(HaveOccurred())
+		Expect(times).To(HaveLen(3))
+		Expect(times[1].Sub(times[0])).To(BeNumerically(">=", 10*time.Millisecond))
+		Expect(times[2].Sub(times[1])).To(BeNumerically(">=", 20*time.Millisecond))
+	})
+
+	It("stops retrying if the context is canceled while waiting", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		attempt := 0
+
+		imbue.With0(
+			container,
+			func(imbue.Context) (Concrete1, error) {
+				attempt++
+				cancel()
+				return "", errors.New("<error>")
+			},
+			imbue.Retry(imbue.RetryPolicy{
+				Attempts: 3,
+				Backoff:  time.Hour,
+			}),
+		)
+
+		_, err := imbue.Get[Concrete1](ctx, container)
+		Expect(err).To(MatchError(ContainSubstring("<error>")))
+		Expect(attempt).To(Equal(1))
+	})
+
+	It("applies the timeout to each attempt separately", func() {
+		var attempt atomic.Int32
+
+		imbue.With0(
+			container,
+			func(ctx imbue.Context) (Concrete1, error) {
+				if attempt.Add(1) == 1 {
+					<-ctx.Done()
+					return "", ctx.Err()
+				}
+
+				_, ok := ctx.Deadline()
+				Expect(ok).To(BeTrue())
+				Expect(ctx.Err()).ShouldNot(HaveOccurred())
+
+				return "<concrete>", nil
+			},
+			imbue.Timeout(10*time.Millisecond),
+			imbue.Retry(imbue.RetryPolicy{
+				Attempts: 2,
+				ShouldRetry: func(err error) bool {
+					return errors.As(err, &imbue.TimeoutError{})
+				},
+			}),
+		)
+
+		v, err := imbue.Get[Concrete1](context.Background(), container)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(v).To(Equal(Concrete1("<concrete>")))
+	})
+
+	It("does not retry until an attempt that timed out has returned", func() {
+		var calls, active, maxActive atomic.Int32
+
+		imbue.With0(
+			container,
+			func(ctx imbue.Context) (Concrete1, error) {
+				n := active.Add(1)
+				defer active.Add(-1)
+
+				if n > maxActive.Load() {
+					maxActive.Store(n)
+				}
+
+				if calls.Add(1) == 1 {
+					<-ctx.Done()
+					time.Sleep(20 * time.Millisecond) // ignore the deadline for a while
+					return "", ctx.Err()
+				}
+
+				return "<concrete>", nil
+			},
+			imbue.Timeout(50*time.Millisecond),
+			imbue.Retry(imbue.RetryPolicy{Attempts: 2}),
+		)
+
+		v, err := imbue.Get[Concrete1](context.Background(), container)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(v).To(Equal(Concrete1("<concrete>")))
+		Expect(calls.Load()).To(BeEquivalentTo(2))
+		Expect(maxActive.Load()).To(BeEquivalentTo(1))
+	})
+
+	It("does not retry if the constructor panics", func() {
+		attempt := 0
+
+		imbue.With0(
+			container,
+			func(ctx imbue.Context) (Concrete1, error) {
+				attempt++
+				panic("<panic>")
+			},
+			imbue.Retry(imbue.RetryPolicy{Attempts: 3}),
+		)
+
+		Expect(func() {
+			imbue.Get[Concrete1](context.Background(), container)
+		}).To(PanicWith("<panic>"))
+		Expect(attempt).To(Equal(1))
+	})
+})
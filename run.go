@@ -0,0 +1,93 @@
+package imbue
+
+import (
+	"context"
+	"errors"
+	"os"
+	"os/signal"
+	"syscall"
+)
+
+// RunOption is an option that changes the behavior of a call to Run().
+type RunOption interface {
+	applyRunOption(*runOptions)
+}
+
+// runOptions is the set of options that apply to a single call to Run().
+type runOptions struct {
+	// signals is the set of signals that cause the group's context to be
+	// canceled.
+	signals []os.Signal
+}
+
+// newRunOptions returns the runOptions described by the given options.
+func newRunOptions(options []RunOption) runOptions {
+	opts := runOptions{
+		signals: []os.Signal{os.Interrupt, syscall.SIGTERM},
+	}
+
+	for _, opt := range options {
+		opt.applyRunOption(&opts)
+	}
+
+	return opts
+}
+
+// Signals is a RunOption that sets the signals that cause Run() to stop.
+//
+// It replaces the default signals, which are SIGINT and SIGTERM.
+func Signals(signals ...os.Signal) RunOption {
+	return option{
+		forRun: func(opts *runOptions) {
+			opts.signals = signals
+		},
+	}
+}
+
+// Run starts a new WaitGroup that is bound to con, waits for it to finish,
+// then closes the container.
+//
+// fn is called to start the group's functions, typically via the GoX()
+// functions.
+//
+// The group's context is canceled if the process receives an interrupt (SIGINT)
+// or termination (SIGTERM) signal. Once a signal has been received, errors that
+// are caused by the cancellation of the context are ignored.
+//
+// Only the first signal is intercepted. Once it has been received, the default
+// behavior of the signals is restored, such that sending another signal
+// terminates a process that does not shut down in a timely manner.
+//
+// The returned error includes any error from the wait group and from closing
+// the container.
+func Run(
+	ctx context.Context,
+	con *Container,
+	fn func(*WaitGroup),
+	options ...RunOption,
+) error {
+	opts := newRunOptions(options)
+
+	signalCtx, stop := signal.NotifyContext(ctx, opts.signals...)
+	defer stop()
+
+	go func() {
+		<-signalCtx.Done()
+		stop()
+	}()
+
+	g := con.WaitGroup(signalCtx)
+	fn(g)
+
+	err := g.Wait()
+
+	if errors.Is(err, context.Canceled) &&
+		signalCtx.Err() != nil &&
+		ctx.Err() == nil {
+		// The context was canceled by a signal, which is the expected way to
+		// stop the group, and not an error.
+		err = nil
+	}
+
+	return errors.Join(err, con.Close())
+}
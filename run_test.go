@@ -0,0 +1,116 @@
+package imbue_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"syscall"
+
+	"github.com/dogmatiq/imbue"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("func Run()", func() {
+	var container *imbue.Container
+
+	BeforeEach(func() {
+		container = imbue.New()
+	})
+
+	It("cancels the context when the process receives a signal", func() {
+		closed := false
+
+		imbue.With0(
+			container,
+			func(ctx imbue.Context) (Concrete1, error) {
+				ctx.Defer(func() error {
+					closed = true
+					return nil
+				})
+				return "<concrete>", nil
+			},
+		)
+
+		err := imbue.Run(
+			context.Background(),
+			container,
+			func(g *imbue.WaitGroup) {
+				imbue.Go1(
+					g,
+					func(ctx context.Context, dep Concrete1) error {
+						p, err := os.FindProcess(os.Getpid())
+						if err != nil {
+							return err
+						}
+
+						if err := p.Signal(syscall.SIGHUP); err != nil {
+							return err
+						}
+
+						<-ctx.Done()
+						return ctx.Err()
+					},
+				)
+			},
+			// Use a signal that is not intercepted by Ginkgo.
+			imbue.Signals(syscall.SIGHUP),
+		)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(closed).To(BeTrue())
+	})
+
+	It("returns errors from the wait group and from closing the container", func() {
+		imbue.With0(
+			container,
+			func(ctx imbue.Context) (Concrete1, error) {
+				ctx.Defer(func() error {
+					return errors.New("<close error>")
+				})
+				return "<concrete>", nil
+			},
+		)
+
+		err := imbue.Run(
+			context.Background(),
+			container,
+			func(g *imbue.WaitGroup) {
+				imbue.Go1(
+					g,
+					func(ctx context.Context, dep Concrete1) error {
+						return errors.New("<task error>")
+					},
+				)
+			},
+		)
+		Expect(err).To(
+			MatchError(
+				MatchRegexp(
+					`^goroutine \(run_test\.go:\d+\) failed: <task error>\n` +
+						`1 error\(s\) occurred while closing the container:\n` +
+						`\t1\) function deferred at run_test\.go:\d+ by imbue_test\.Concrete1 constructor \(run_test\.go:\d+\) failed: <close error>$`,
+				),
+			),
+		)
+	})
+
+	It("does not ignore cancellation errors if the parent context is canceled", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+
+		err := imbue.Run(
+			ctx,
+			container,
+			func(g *imbue.WaitGroup) {
+				imbue.Go0(
+					g,
+					func(ctx context.Context) error {
+						cancel()
+						<-ctx.Done()
+						return ctx.Err()
+					},
+				)
+			},
+		)
+		Expect(err).To(MatchError(context.Canceled))
+	})
+})
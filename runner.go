@@ -0,0 +1,97 @@
+package imbue
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+)
+
+// Runner is an interface for dependencies that perform some long-running
+// task, such as servers, message consumers and schedulers.
+//
+// Every declared dependency that implements Runner is run by
+// Container.RunAll().
+type Runner interface {
+	// Run performs the task until it is complete or ctx is canceled.
+	Run(ctx context.Context) error
+}
+
+// RunAll runs every declared dependency that implements the Runner interface.
+//
+// Each runner is run in its own goroutine within the same WaitGroup. If any
+// runner fails, the context passed to the other runners is canceled. It blocks
+// until all of the runners have returned, then returns an error describing the
+// first runner that failed, if any.
+//
+// Dependencies are only considered to be runners if their declared type
+// implements the Runner interface. For named and grouped dependencies, it is
+// the type of the named or grouped value that must implement Runner.
+func (c *Container) RunAll(ctx context.Context) error {
+	if err := c.Err(); err != nil {
+		return err
+	}
+
+	c.m.Lock()
+	declarations := sortDeclarations(c.declarations)
+	c.m.Unlock()
+
+	g := c.WaitGroup(ctx)
+
+	for _, d := range declarations {
+		if d.IsDeclared() && d.ValueType().Implements(typeOf[Runner]()) {
+			r := runner{d}
+
+			g.group.Go(func() error {
+				return r.Run(g.ctx)
+			})
+		}
+	}
+
+	return g.Wait()
+}
+
+// runner is a declaration of a type that implements the Runner interface.
+type runner struct {
+	decl declaration
+}
+
+// Run resolves the runner's value and runs it.
+func (r runner) Run(ctx context.Context) (err error) {
+	defer func() {
+		if v := recover(); v != nil {
+			err = fmt.Errorf(
+				"%s failed: %w",
+				r,
+				PanicError{v, debug.Stack()},
+			)
+		}
+	}()
+
+	v, err := r.decl.ResolveAny(ctx)
+	if err != nil {
+		return err
+	}
+
+	if w, ok := v.(wrapper); ok {
+		v = w.unwrap().Value
+	}
+
+	if err := v.(Runner).Run(ctx); err != nil {
+		return fmt.Errorf(
+			"%s failed: %w",
+			r,
+			err,
+		)
+	}
+
+	return nil
+}
+
+// String returns a description of the runner for use in error messages.
+func (r runner) String() string {
+	return fmt.Sprintf(
+		"%s runner (%s)",
+		r.decl.Type(),
+		r.decl.BestLocation(),
+	)
+}
@@ -0,0 +1,191 @@
+package imbue_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+
+	"github.com/dogmatiq/imbue"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// testRunner is an implementation of imbue.Runner used for testing.
+type testRunner struct {
+	run func(ctx context.Context) error
+}
+
+func (r *testRunner) Run(ctx context.Context) error {
+	return r.run(ctx)
+}
+
+type (
+	Runner1 struct{ *testRunner }
+	Runner2 struct{ *testRunner }
+
+	// Worker is a name for a Runner1.
+	Worker imbue.Name[Runner1]
+)
+
+var _ = Describe("type Container", func() {
+	Describe("func RunAll()", func() {
+		var container *imbue.Container
+
+		BeforeEach(func() {
+			container = imbue.New()
+		})
+
+		AfterEach(func() {
+			container.Close()
+		})
+
+		It("runs every declared dependency that implements Runner", func() {
+			var count atomic.Int32
+
+			imbue.With0(
+				container,
+				func(ctx imbue.Context) (Runner1, error) {
+					return Runner1{&testRunner{
+						func(ctx context.Context) error {
+							count.Add(1)
+							return nil
+						},
+					}}, nil
+				},
+			)
+
+			imbue.With1(
+				container,
+				func(ctx imbue.Context, dep Runner1) (Runner2, error) {
+					return Runner2{&testRunner{
+						func(ctx context.Context) error {
+							count.Add(1)
+							return nil
+						},
+					}}, nil
+				},
+			)
+
+			imbue.With0(
+				container,
+				func(ctx imbue.Context) (Concrete1, error) {
+					panic("unexpected call")
+				},
+			)
+
+			err := container.RunAll(context.Background())
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(count.Load()).To(BeEquivalentTo(2))
+		})
+
+		It("runs named and grouped dependencies that implement Runner", func() {
+			var count atomic.Int32
+
+			imbue.With0Named[Worker](
+				container,
+				func(ctx imbue.Context) (Runner1, error) {
+					return Runner1{&testRunner{
+						func(ctx context.Context) error {
+							count.Add(1)
+							return nil
+						},
+					}}, nil
+				},
+			)
+
+			imbue.With0Grouped[ServiceA](
+				container,
+				func(ctx imbue.Context) (Runner2, error) {
+					return Runner2{&testRunner{
+						func(ctx context.Context) error {
+							count.Add(1)
+							return nil
+						},
+					}}, nil
+				},
+			)
+
+			err := container.RunAll(context.Background())
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(count.Load()).To(BeEquivalentTo(2))
+		})
+
+		It("reports which runner failed and cancels the others", func() {
+			imbue.With0(
+				container,
+				func(ctx imbue.Context) (Runner1, error) {
+					return Runner1{&testRunner{
+						func(ctx context.Context) error {
+							return errors.New("<error>")
+						},
+					}}, nil
+				},
+			)
+
+			imbue.With0(
+				container,
+				func(ctx imbue.Context) (Runner2, error) {
+					return Runner2{&testRunner{
+						func(ctx context.Context) error {
+							<-ctx.Done()
+							return nil
+						},
+					}}, nil
+				},
+			)
+
+			err := container.RunAll(context.Background())
+			Expect(err).To(
+				MatchError(
+					MatchRegexp(
+						`^imbue_test\.Runner1 runner \(runner_test\.go:\d+\) failed: <error>$`,
+					),
+				),
+			)
+		})
+
+		It("returns an error if a runner panics", func() {
+			imbue.With0(
+				container,
+				func(ctx imbue.Context) (Runner1, error) {
+					return Runner1{&testRunner{
+						func(ctx context.Context) error {
+							panic("<panic>")
+						},
+					}}, nil
+				},
+			)
+
+			err := container.RunAll(context.Background())
+			Expect(err).To(
+				MatchError(
+					MatchRegexp(
+						`^imbue_test\.Runner1 runner \(runner_test\.go:\d+\) failed: panic: <panic>$`,
+					),
+				),
+			)
+
+			var panicErr imbue.PanicError
+			Expect(errors.As(err, &panicErr)).To(BeTrue())
+			Expect(string(panicErr.Stack)).To(ContainSubstring("runner_test.go"))
+		})
+
+		It("returns an error if a runner can not be constructed", func() {
+			imbue.With0(
+				container,
+				func(ctx imbue.Context) (Runner1, error) {
+					return Runner1{}, errors.New("<error>")
+				},
+			)
+
+			err := container.RunAll(context.Background())
+			Expect(err).To(
+				MatchError(
+					MatchRegexp(
+						`^imbue_test\.Runner1 constructor \(runner_test\.go:\d+\) failed: <error>$`,
+					),
+				),
+			)
+		})
+	})
+})
@@ -0,0 +1,200 @@
+package imbue
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"slices"
+	"time"
+)
+
+// Timeout is a DeclarationOption that limits the amount of time that the
+// constructor and decorators of a single declaration may take to construct
+// the value.
+//
+// The value's dependencies are constructed before the timeout begins, so the
+// time taken to construct them does not count towards the timeout. Use the
+// Timeout() option on the dependencies' declarations to limit their
+// construction separately.
+//
+// The Context passed to the constructor and decorators is canceled once the
+// timeout elapses. If construction has not completed by then, it fails with a
+// TimeoutError, even if the constructor does not observe the cancellation.
+//
+// The constructor is never called while a previous call that timed out is
+// still running. A subsequent attempt to construct the value first waits for
+// the previous call to return, and this wait counts towards the new attempt's
+// timeout.
+func Timeout(d time.Duration) DeclarationOption {
+	return option{
+		forWith: func(opts *withOptions) {
+			opts.timeout = d
+		},
+	}
+}
+
+// TimeoutError is returned when a constructor, along with any decorators, does
+// not construct its value within the duration given by the Timeout() option.
+type TimeoutError struct {
+	// Constructor describes the constructor that timed out.
+	Constructor TypeInfo
+
+	// Timeout is the duration given by the Timeout() option.
+	Timeout time.Duration
+}
+
+func (e TimeoutError) Error() string {
+	return fmt.Sprintf(
+		"%s constructor (%s) did not complete within %s",
+		e.Constructor.Type,
+		location{File: e.Constructor.File, Line: e.Constructor.Line},
+		e.Timeout,
+	)
+}
+
+// Unwrap returns context.DeadlineExceeded.
+func (e TimeoutError) Unwrap() error {
+	return context.DeadlineExceeded
+}
+
+// constructWithTimeout calls the constructor and decorators to construct a new
+// value, failing if they do not complete within d.timeout.
+//
+// Any functions they defer are added to defers. If construction is abandoned
+// because it takes too long, the functions it defers are instead called once
+// it completes.
+//
+// d.m must be locked.
+func (d *declarationOf[T]) constructWithTimeout(
+	ctx context.Context,
+	defers *deferSet,
+) (T, error) {
+	var zero T
+
+	if err := d.resolveDependencies(ctx); err != nil {
+		return zero, err
+	}
+
+	timeoutErr := TimeoutError{
+		typeInfoOf(d.constructor),
+		d.timeout,
+	}
+
+	ctx, cancel := context.WithTimeoutCause(ctx, d.timeout, timeoutErr)
+	defer cancel()
+
+	// Wait for any previous attempt that was abandoned because it timed out,
+	// so that the constructor is never called concurrently. For example, a
+	// constructor that connects to a database must not hold two connections
+	// open at once.
+	if d.abandoned != nil {
+		select {
+		case <-d.abandoned:
+			d.abandoned = nil
+		case <-ctx.Done():
+			return zero, d.timeoutError(ctx, timeoutErr)
+		}
+	}
+
+	type result struct {
+		value    T
+		err      error
+		panicked bool
+		panic    any
+	}
+
+	// Take copies of the functions, as construction may be abandoned and
+	// retried while this attempt is still running.
+	ctor := d.constructor
+	decorators := slices.Clone(d.decorators)
+
+	pending := &deferSet{}
+	done := make(chan result, 1)
+
+	go func() {
+		var r result
+
+		defer func() {
+			if p := recover(); p != nil {
+				r.panicked = true
+				r.panic = p
+			}
+			done <- r
+		}()
+
+		r.value, r.err = d.construct(ctx, ctor, decorators, pending)
+	}()
+
+	select {
+	case r := <-done:
+		pending.TransferOwnership(defers)
+
+		if r.panicked {
+			panic(r.panic)
+		}
+
+		if r.err != nil && context.Cause(ctx) == timeoutErr {
+			return r.value, timeoutErr
+		}
+
+		return r.value, r.err
+
+	case <-ctx.Done():
+		// Abandon construction, but call any functions it defers once it
+		// finally completes.
+		abandoned := make(chan struct{})
+		d.abandoned = abandoned
+
+		go func() {
+			<-done
+			pending.Call(d.observer)
+			close(abandoned)
+		}()
+
+		return zero, d.timeoutError(ctx, timeoutErr)
+	}
+}
+
+// timeoutError returns the error to return when ctx, the context created by
+// constructWithTimeout(), is done.
+func (d *declarationOf[T]) timeoutError(
+	ctx context.Context,
+	timeoutErr TimeoutError,
+) error {
+	if context.Cause(ctx) == timeoutErr {
+		return timeoutErr
+	}
+
+	return fmt.Errorf(
+		"%s failed: %w",
+		d.constructor,
+		ctx.Err(),
+	)
+}
+
+// resolveDependencies resolves the dependencies of d's constructor and
+// decorators, such that they are already constructed when the constructor and
+// decorators request them.
+//
+// An error from a dependency is returned in the same form as it would be had
+// the dependency been resolved by the constructor or decorator itself.
+//
+// d.m must be locked.
+func (d *declarationOf[T]) resolveDependencies(ctx context.Context) error {
+	deps := make(map[reflect.Type]declaration, len(d.deps))
+	for t, dep := range d.deps {
+		deps[t] = dep.Declaration
+	}
+
+	for _, dep := range sortDeclarations(deps) {
+		if _, err := dep.ResolveAny(ctx); err != nil {
+			return fmt.Errorf(
+				"%s failed: %w",
+				d.deps[dep.Type()].Scope,
+				err,
+			)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,250 @@
+package imbue_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"time"
+
+	"github.com/dogmatiq/imbue"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("func Timeout()", func() {
+	var container *imbue.Container
+
+	BeforeEach(func() {
+		container = imbue.New()
+	})
+
+	AfterEach(func() {
+		container.Close()
+	})
+
+	It("does not affect constructors that complete in time", func() {
+		imbue.With0(
+			container,
+			func(ctx imbue.Context) (Concrete1, error) {
+				_, ok := ctx.Deadline()
+				Expect(ok).To(BeTrue())
+				return "<concrete>", nil
+			},
+			imbue.Timeout(time.Hour),
+		)
+
+		v, err := imbue.Get[Concrete1](context.Background(), container)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(v).To(Equal(Concrete1("<concrete>")))
+	})
+
+	It("returns a timeout error if the constructor observes the deadline", func() {
+		imbue.With0(
+			container,
+			func(ctx imbue.Context) (Concrete1, error) {
+				<-ctx.Done()
+				return "", ctx.Err()
+			},
+			imbue.Timeout(time.Millisecond),
+		)
+
+		_, err := imbue.Get[Concrete1](context.Background(), container)
+		Expect(err).To(MatchError(MatchRegexp(
+			`^imbue_test\.Concrete1 constructor \(timeout_test\.go:\d+\) did not complete within 1ms$`,
+		)))
+
+		var timeoutErr imbue.TimeoutError
+		Expect(errors.As(err, &timeoutErr)).To(BeTrue())
+		Expect(timeoutErr.Constructor.File).To(HaveSuffix("timeout_test.go"))
+		Expect(timeoutErr.Timeout).To(Equal(time.Millisecond))
+		Expect(err).To(MatchError(context.DeadlineExceeded))
+	})
+
+	It("returns a timeout error if the constructor ignores the deadline", func() {
+		release := make(chan struct{})
+		called := make(chan struct{})
+
+		imbue.With0(
+			container,
+			func(ctx imbue.Context) (Concrete1, error) {
+				ctx.Defer(func() error {
+					close(called)
+					return nil
+				})
+
+				<-release
+				return "<concrete>", nil
+			},
+			imbue.Timeout(time.Millisecond),
+		)
+
+		_, err := imbue.Get[Concrete1](context.Background(), container)
+		Expect(err).To(BeAssignableToTypeOf(imbue.TimeoutError{}))
+
+		close(release)
+		Eventually(called).Should(BeClosed())
+	})
+
+	It("does not include the time taken to construct dependencies", func() {
+		imbue.With0(
+			container,
+			func(ctx imbue.Context) (Concrete2, error) {
+				time.Sleep(20 * time.Millisecond)
+				return "<concrete>", nil
+			},
+		)
+
+		imbue.With1(
+			container,
+			func(ctx imbue.Context, dep Concrete2) (Concrete1, error) {
+				return "<concrete>", nil
+			},
+			imbue.Timeout(10*time.Millisecond),
+		)
+
+		_, err := imbue.Get[Concrete1](context.Background(), container)
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+
+	It("attributes a timeout to the constructor that timed out", func() {
+		imbue.With0(
+			container,
+			func(ctx imbue.Context) (Concrete2, error) {
+				<-ctx.Done()
+				return "", ctx.Err()
+			},
+			imbue.Timeout(time.Millisecond),
+		)
+
+		imbue.With1(
+			container,
+			func(ctx imbue.Context, dep Concrete2) (Concrete1, error) {
+				return "<concrete>", nil
+			},
+			imbue.Timeout(time.Hour),
+		)
+
+		_, err := imbue.Get[Concrete1](context.Background(), container)
+		Expect(err).To(MatchError(MatchRegexp(
+			`^imbue_test\.Concrete1 constructor \(timeout_test\.go:\d+\) failed: imbue_test\.Concrete2 constructor \(timeout_test\.go:\d+\) did not complete within 1ms$`,
+		)))
+
+		var timeoutErr imbue.TimeoutError
+		Expect(errors.As(err, &timeoutErr)).To(BeTrue())
+		Expect(timeoutErr.Constructor.Type.String()).To(Equal("imbue_test.Concrete2"))
+	})
+
+	It("does not call the constructor while a call that timed out is still running", func() {
+		var calls, active, maxActive atomic.Int32
+		release := make(chan struct{})
+
+		imbue.With0(
+			container,
+			func(ctx imbue.Context) (Concrete1, error) {
+				calls.Add(1)
+				n := active.Add(1)
+				defer active.Add(-1)
+
+				if n > maxActive.Load() {
+					maxActive.Store(n)
+				}
+
+				<-release
+				return "<concrete>", nil
+			},
+			imbue.Timeout(10*time.Millisecond),
+		)
+
+		_, err := imbue.Get[Concrete1](context.Background(), container)
+		Expect(err).To(BeAssignableToTypeOf(imbue.TimeoutError{}))
+
+		_, err = imbue.Get[Concrete1](context.Background(), container)
+		Expect(err).To(BeAssignableToTypeOf(imbue.TimeoutError{}))
+		Expect(calls.Load()).To(BeEquivalentTo(1))
+
+		close(release)
+
+		v, err := imbue.Get[Concrete1](context.Background(), container)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(v).To(Equal(Concrete1("<concrete>")))
+		Expect(calls.Load()).To(BeEquivalentTo(2))
+		Expect(maxActive.Load()).To(BeEquivalentTo(1))
+	})
+
+	It("applies the timeout to decorators", func() {
+		imbue.With0(
+			container,
+			func(ctx imbue.Context) (Concrete1, error) {
+				return "<concrete>", nil
+			},
+			imbue.Timeout(time.Millisecond),
+		)
+
+		imbue.Decorate0(
+			container,
+			func(ctx imbue.Context, v Concrete1) (Concrete1, error) {
+				<-ctx.Done()
+				return v, ctx.Err()
+			},
+		)
+
+		_, err := imbue.Get[Concrete1](context.Background(), container)
+		Expect(err).To(BeAssignableToTypeOf(imbue.TimeoutError{}))
+	})
+
+	It("allows construction to be retried after a timeout", func() {
+		var attempt atomic.Int32
+
+		imbue.With0Named[Foreground](
+			container,
+			func(ctx imbue.Context) (Color, error) {
+				if attempt.Add(1) == 1 {
+					<-ctx.Done()
+				}
+				return "<color>", nil
+			},
+			imbue.Timeout(time.Millisecond),
+		)
+
+		_, err := imbue.Get[imbue.ByName[Foreground, Color]](context.Background(), container)
+		Expect(err).To(BeAssignableToTypeOf(imbue.TimeoutError{}))
+
+		v, err := imbue.Get[imbue.ByName[Foreground, Color]](context.Background(), container)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(v.Value()).To(Equal(Color("<color>")))
+	})
+
+	It("propagates panics from the constructor", func() {
+		imbue.With0(
+			container,
+			func(ctx imbue.Context) (Concrete1, error) {
+				panic("<panic>")
+			},
+			imbue.Timeout(time.Hour),
+		)
+
+		Expect(func() {
+			imbue.Get[Concrete1](context.Background(), container)
+		}).To(PanicWith("<panic>"))
+	})
+
+	It("returns an error if the parent context is canceled", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		release := make(chan struct{})
+		defer close(release)
+
+		imbue.With0(
+			container,
+			func(imbue.Context) (Concrete1, error) {
+				cancel()
+				<-release
+				return "<concrete>", nil
+			},
+			imbue.Timeout(time.Hour),
+		)
+
+		_, err := imbue.Get[Concrete1](ctx, container)
+		Expect(err).To(MatchError(context.Canceled))
+		Expect(err).NotTo(BeAssignableToTypeOf(imbue.TimeoutError{}))
+	})
+})
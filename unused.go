@@ -0,0 +1,59 @@
+package imbue
+
+// Unused returns the declarations that have constructors but are not used by
+// the application.
+//
+// A declaration is considered unused if it is not reachable from any call to
+// InvokeX() or GoX() that has been made so far, and its value has not been
+// constructed. Implicit declarations are never reported.
+//
+// The declarations are sorted by type. The result is most meaningful after the
+// application has run, once every InvokeX() and GoX() call has had a chance to
+// occur.
+func (c *Container) Unused() []GraphNode {
+	c.m.Lock()
+	declarations := sortDeclarations(c.declarations)
+	invocations := sortInvocations(c.invocations)
+	c.m.Unlock()
+
+	reachable := map[declaration]struct{}{}
+	for _, inv := range invocations {
+		for _, dep := range inv.deps {
+			markReachable(dep, reachable)
+		}
+	}
+
+	unused := []GraphNode{}
+
+	for _, d := range declarations {
+		if !d.IsDeclared() || d.IsImplicit() {
+			continue
+		}
+
+		if _, ok := reachable[d]; ok {
+			continue
+		}
+
+		if _, isConstructed := d.ConstructedValue(); isConstructed {
+			continue
+		}
+
+		unused = append(unused, graphNodeOf(d))
+	}
+
+	return unused
+}
+
+// markReachable adds d and all of its (possibly indirect) dependencies to
+// reachable.
+func markReachable(d declaration, reachable map[declaration]struct{}) {
+	if _, ok := reachable[d]; ok {
+		return
+	}
+
+	reachable[d] = struct{}{}
+
+	for _, dep := range d.Dependencies() {
+		markReachable(dep, reachable)
+	}
+}
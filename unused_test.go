@@ -0,0 +1,133 @@
+package imbue_test
+
+import (
+	"context"
+
+	"github.com/dogmatiq/imbue"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("type Container", func() {
+	var container *imbue.Container
+
+	BeforeEach(func() {
+		container = imbue.New()
+	})
+
+	AfterEach(func() {
+		container.Close()
+	})
+
+	Describe("func Unused()", func() {
+		It("returns declarations that are not reachable from any invocation", func() {
+			imbue.With0(
+				container,
+				func(ctx imbue.Context) (Concrete1, error) {
+					return "<concrete>", nil
+				},
+			)
+
+			imbue.With1(
+				container,
+				func(ctx imbue.Context, dep Concrete1) (Concrete2, error) {
+					return "<concrete>", nil
+				},
+			)
+
+			imbue.With1(
+				container,
+				func(ctx imbue.Context, dep Concrete1) (Concrete3, error) {
+					return "<concrete>", nil
+				},
+			)
+
+			imbue.With0Named[Foreground](
+				container,
+				func(ctx imbue.Context) (Color, error) {
+					return "<color>", nil
+				},
+			)
+
+			err := imbue.Invoke1(
+				context.Background(),
+				container,
+				func(ctx context.Context, dep Concrete2) error {
+					return nil
+				},
+			)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			var types []string
+			for _, n := range container.Unused() {
+				types = append(types, n.Type)
+				Expect(n.Constructor).NotTo(BeNil())
+			}
+
+			Expect(types).To(Equal([]string{
+				"imbue_test.Color",
+				"imbue_test.Concrete3",
+			}))
+		})
+
+		It("considers reachable declarations to be used before they are constructed", func() {
+			imbue.With0(
+				container,
+				func(ctx imbue.Context) (Concrete1, error) {
+					return "<concrete>", nil
+				},
+			)
+
+			imbue.With1(
+				container,
+				func(ctx imbue.Context, dep imbue.Optional[Concrete1]) (Concrete2, error) {
+					return "<concrete>", nil
+				},
+			)
+
+			err := imbue.Invoke1(
+				context.Background(),
+				container,
+				func(ctx context.Context, dep Concrete2) error {
+					return nil
+				},
+			)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			Expect(container.Unused()).To(BeEmpty())
+		})
+
+		It("does not report declarations that have been constructed", func() {
+			imbue.With0(
+				container,
+				func(ctx imbue.Context) (Concrete1, error) {
+					return "<concrete>", nil
+				},
+				imbue.Eager(),
+			)
+
+			err := container.Build(context.Background())
+			Expect(err).ShouldNot(HaveOccurred())
+
+			Expect(container.Unused()).To(BeEmpty())
+		})
+
+		It("does not report types without constructors", func() {
+			imbue.With1(
+				container,
+				func(ctx imbue.Context, dep Concrete1) (Concrete2, error) {
+					return "<concrete>", nil
+				},
+			)
+
+			var types []string
+			for _, n := range container.Unused() {
+				types = append(types, n.Type)
+			}
+
+			Expect(types).To(Equal([]string{
+				"imbue_test.Concrete2",
+			}))
+		})
+	})
+})
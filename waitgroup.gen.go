@@ -10,9 +10,12 @@ func Go1[D any](
 	fn func(context.Context, D) error,
 	options ...InvokeOption,
 ) {
-	g.group.Go(func() error {
-		return Invoke1(g.ctx, g.con, fn, options...)
-	})
+	g.start(
+		func(ctx context.Context, options []InvokeOption) error {
+			return Invoke1(ctx, g.con, fn, options...)
+		},
+		options,
+	)
 }
 
 // Go2 starts a new goroutine by calling a function with 2 dependencies.
@@ -21,9 +24,12 @@ func Go2[D1, D2 any](
 	fn func(context.Context, D1, D2) error,
 	options ...InvokeOption,
 ) {
-	g.group.Go(func() error {
-		return Invoke2(g.ctx, g.con, fn, options...)
-	})
+	g.start(
+		func(ctx context.Context, options []InvokeOption) error {
+			return Invoke2(ctx, g.con, fn, options...)
+		},
+		options,
+	)
 }
 
 // Go3 starts a new goroutine by calling a function with 3 dependencies.
@@ -32,9 +38,12 @@ func Go3[D1, D2, D3 any](
 	fn func(context.Context, D1, D2, D3) error,
 	options ...InvokeOption,
 ) {
-	g.group.Go(func() error {
-		return Invoke3(g.ctx, g.con, fn, options...)
-	})
+	g.start(
+		func(ctx context.Context, options []InvokeOption) error {
+			return Invoke3(ctx, g.con, fn, options...)
+		},
+		options,
+	)
 }
 
 // Go4 starts a new goroutine by calling a function with 4 dependencies.
@@ -43,9 +52,12 @@ func Go4[D1, D2, D3, D4 any](
 	fn func(context.Context, D1, D2, D3, D4) error,
 	options ...InvokeOption,
 ) {
-	g.group.Go(func() error {
-		return Invoke4(g.ctx, g.con, fn, options...)
-	})
+	g.start(
+		func(ctx context.Context, options []InvokeOption) error {
+			return Invoke4(ctx, g.con, fn, options...)
+		},
+		options,
+	)
 }
 
 // Go5 starts a new goroutine by calling a function with 5 dependencies.
@@ -54,9 +66,12 @@ func Go5[D1, D2, D3, D4, D5 any](
 	fn func(context.Context, D1, D2, D3, D4, D5) error,
 	options ...InvokeOption,
 ) {
-	g.group.Go(func() error {
-		return Invoke5(g.ctx, g.con, fn, options...)
-	})
+	g.start(
+		func(ctx context.Context, options []InvokeOption) error {
+			return Invoke5(ctx, g.con, fn, options...)
+		},
+		options,
+	)
 }
 
 // Go6 starts a new goroutine by calling a function with 6 dependencies.
@@ -65,9 +80,12 @@ func Go6[D1, D2, D3, D4, D5, D6 any](
 	fn func(context.Context, D1, D2, D3, D4, D5, D6) error,
 	options ...InvokeOption,
 ) {
-	g.group.Go(func() error {
-		return Invoke6(g.ctx, g.con, fn, options...)
-	})
+	g.start(
+		func(ctx context.Context, options []InvokeOption) error {
+			return Invoke6(ctx, g.con, fn, options...)
+		},
+		options,
+	)
 }
 
 // Go7 starts a new goroutine by calling a function with 7 dependencies.
@@ -76,9 +94,12 @@ func Go7[D1, D2, D3, D4, D5, D6, D7 any](
 	fn func(context.Context, D1, D2, D3, D4, D5, D6, D7) error,
 	options ...InvokeOption,
 ) {
-	g.group.Go(func() error {
-		return Invoke7(g.ctx, g.con, fn, options...)
-	})
+	g.start(
+		func(ctx context.Context, options []InvokeOption) error {
+			return Invoke7(ctx, g.con, fn, options...)
+		},
+		options,
+	)
 }
 
 // Go8 starts a new goroutine by calling a function with 8 dependencies.
@@ -87,7 +108,10 @@ func Go8[D1, D2, D3, D4, D5, D6, D7, D8 any](
 	fn func(context.Context, D1, D2, D3, D4, D5, D6, D7, D8) error,
 	options ...InvokeOption,
 ) {
-	g.group.Go(func() error {
-		return Invoke8(g.ctx, g.con, fn, options...)
-	})
+	g.start(
+		func(ctx context.Context, options []InvokeOption) error {
+			return Invoke8(ctx, g.con, fn, options...)
+		},
+		options,
+	)
 }
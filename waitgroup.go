@@ -2,6 +2,10 @@ package imbue
 
 import (
 	"context"
+	"fmt"
+	"runtime/debug"
+	"slices"
+	"time"
 
 	"golang.org/x/sync/errgroup"
 )
@@ -20,3 +24,260 @@ type WaitGroup struct {
 func (g *WaitGroup) Wait() error {
 	return g.group.Wait()
 }
+
+// SetLimit limits the number of active goroutines in this group to at most n.
+// A negative value indicates no limit.
+//
+// Any subsequent call to a GoX() function blocks until it can start the
+// goroutine without exceeding the limit. Therefore, functions that start other
+// functions within the same group may deadlock if the limit is reached.
+//
+// The limit must not be modified while any goroutines in the group are active.
+func (g *WaitGroup) SetLimit(n int) {
+	g.group.SetLimit(n)
+}
+
+// WaitGroupFromContext returns the WaitGroup that started the function that
+// was passed ctx.
+//
+// It allows functions started by GoX() to start additional functions within the
+// same group. ok is false if ctx was not passed to such a function.
+func WaitGroupFromContext(ctx context.Context) (g *WaitGroup, ok bool) {
+	g, ok = ctx.Value(waitGroupKey{}).(*WaitGroup)
+	return g, ok
+}
+
+// waitGroupKey is the context key used to store the WaitGroup within the
+// context passed to functions started by GoX().
+type waitGroupKey struct{}
+
+// Go0 starts a new goroutine by calling a function without dependencies.
+func Go0(
+	g *WaitGroup,
+	fn func(context.Context) error,
+	options ...InvokeOption,
+) {
+	g.start(
+		func(ctx context.Context, options []InvokeOption) error {
+			return Invoke0(ctx, g.con, fn, options...)
+		},
+		options,
+	)
+}
+
+// start starts a new goroutine that calls fn, restarting it as necessary
+// according to the given options.
+//
+// fn is passed the options with the addition of an option that records the
+// location of the call to GoX(), such that InvokeX() can attribute the
+// invocation to that call.
+func (g *WaitGroup) start(
+	fn func(context.Context, []InvokeOption) error,
+	options []InvokeOption,
+) {
+	opts := newInvokeOptions(options)
+	t := task{
+		opts.name,
+		findLocation(),
+	}
+
+	options = append(
+		slices.Clip(options),
+		startedAt(t.loc),
+	)
+
+	g.group.Go(func() error {
+		err := supervise(
+			g.ctx,
+			t,
+			func(ctx context.Context) error {
+				return fn(ctx, options)
+			},
+			opts,
+		)
+
+		if err == nil {
+			return nil
+		}
+
+		err = fmt.Errorf(
+			"%s failed: %w",
+			t,
+			err,
+		)
+
+		g.con.observers.GoroutineFailed(g.ctx, err)
+
+		if opts.isNonCritical {
+			return nil
+		}
+
+		return err
+	})
+}
+
+// supervise calls fn, restarting it if it returns an error according to the
+// given options.
+func supervise(
+	ctx context.Context,
+	t task,
+	fn func(context.Context) error,
+	opts invokeOptions,
+) error {
+	for restarts := 0; ; restarts++ {
+		err := t.Call(ctx, fn)
+		if err == nil || !opts.restartOnError {
+			return err
+		}
+
+		// Do not restart a function that panics, as doing so is unlikely to
+		// succeed. This includes panics caused by dependencies that have not
+		// been declared.
+		if _, ok := err.(PanicError); ok {
+			return err
+		}
+
+		if opts.maxRestarts >= 0 && restarts >= opts.maxRestarts {
+			return err
+		}
+
+		if ctx.Err() != nil {
+			return err
+		}
+
+		if opts.backoff > 0 {
+			timer := time.NewTimer(opts.backoff)
+
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return err
+			case <-timer.C:
+			}
+		}
+	}
+}
+
+// task describes a function started by GoX().
+type task struct {
+	// name is the name given to the task using the TaskName() option, if any.
+	name string
+
+	// loc is the location of the call to GoX().
+	loc location
+}
+
+// Call calls fn, returning an error if it panics.
+func (t task) Call(ctx context.Context, fn func(context.Context) error) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = PanicError{r, debug.Stack()}
+		}
+	}()
+
+	return fn(ctx)
+}
+
+// String returns a description of the task for use in error messages.
+func (t task) String() string {
+	if t.name != "" {
+		return fmt.Sprintf(
+			"%q goroutine (%s)",
+			t.name,
+			t.loc,
+		)
+	}
+
+	return fmt.Sprintf(
+		"goroutine (%s)",
+		t.loc,
+	)
+}
+
+// PanicError is an error that describes a panic that was recovered from a
+// function started by GoX(), a runner started by Container.RunAll() or a health
+// check.
+type PanicError struct {
+	// Value is the value that was passed to panic().
+	Value any
+
+	// Stack is the stack trace of the goroutine that panicked, as returned by
+	// debug.Stack().
+	Stack []byte
+}
+
+func (e PanicError) Error() string {
+	return fmt.Sprintf("panic: %v", e.Value)
+}
+
+// Unwrap returns the panic value if it is an error.
+func (e PanicError) Unwrap() error {
+	err, _ := e.Value.(error)
+	return err
+}
+
+// TaskName is an InvokeOption that gives a name to a function started by GoX().
+//
+// The name is used to identify the function in error messages.
+//
+// It has no effect when passed to InvokeX().
+func TaskName(name string) InvokeOption {
+	return option{
+		forInvoke: func(opts *invokeOptions) {
+			opts.name = name
+		},
+	}
+}
+
+// Restart is an InvokeOption that causes a function started by GoX() to be
+// called again each time it returns an error.
+//
+// The function is called again after the given backoff duration, with the same
+// dependencies. Restarts stop once the wait group's context is canceled. The
+// function is not restarted if it panics.
+//
+// By default there is no limit to the number of restarts. Use RestartOnError()
+// to impose a limit.
+//
+// It has no effect when passed to InvokeX().
+func Restart(backoff time.Duration) InvokeOption {
+	return option{
+		forInvoke: func(opts *invokeOptions) {
+			opts.restartOnError = true
+			opts.backoff = backoff
+		},
+	}
+}
+
+// RestartOnError is an InvokeOption that causes a function started by GoX() to
+// be called again when it returns an error, up to max times.
+//
+// If the function still fails after max restarts, the error is returned by the
+// wait group. The function is not restarted if it panics. Use Restart() to add a
+// delay between restarts.
+//
+// It has no effect when passed to InvokeX().
+func RestartOnError(max int) InvokeOption {
+	return option{
+		forInvoke: func(opts *invokeOptions) {
+			opts.restartOnError = true
+			opts.maxRestarts = max
+		},
+	}
+}
+
+// NonCritical is an InvokeOption that prevents errors from a function started
+// by GoX() from affecting the rest of the wait group.
+//
+// The error is not returned by WaitGroup.Wait(), and does not cause the wait
+// group's context to be canceled. It is still reported to the container's
+// observers, and logged if the WithLogger() option is used.
+//
+// It has no effect when passed to InvokeX().
+func NonCritical() InvokeOption {
+	return option{
+		forInvoke: func(opts *invokeOptions) {
+			opts.isNonCritical = true
+		},
+	}
+}
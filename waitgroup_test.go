@@ -0,0 +1,359 @@
+package imbue_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"time"
+
+	"github.com/dogmatiq/imbue"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("type WaitGroup", func() {
+	var container *imbue.Container
+
+	BeforeEach(func() {
+		container = imbue.New()
+
+		imbue.With0(
+			container,
+			func(ctx imbue.Context) (Concrete1, error) {
+				return "<concrete>", nil
+			},
+		)
+	})
+
+	AfterEach(func() {
+		container.Close()
+	})
+
+	Describe("func SetLimit()", func() {
+		It("limits the number of active goroutines", func() {
+			g := container.WaitGroup(context.Background())
+			g.SetLimit(1)
+
+			var (
+				active   atomic.Int32
+				exceeded atomic.Bool
+			)
+
+			for i := 0; i < 5; i++ {
+				imbue.Go0(
+					g,
+					func(ctx context.Context) error {
+						if active.Add(1) > 1 {
+							exceeded.Store(true)
+						}
+						defer active.Add(-1)
+
+						time.Sleep(time.Millisecond)
+						return nil
+					},
+				)
+			}
+
+			err := g.Wait()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(exceeded.Load()).To(BeFalse())
+		})
+	})
+
+	Describe("func WaitGroupFromContext()", func() {
+		It("allows functions to start further functions within the same group", func() {
+			g := container.WaitGroup(context.Background())
+
+			var result atomic.Value
+			imbue.Go0(
+				g,
+				func(ctx context.Context) error {
+					g, ok := imbue.WaitGroupFromContext(ctx)
+					Expect(ok).To(BeTrue())
+
+					imbue.Go1(
+						g,
+						func(ctx context.Context, dep Concrete1) error {
+							result.Store(dep)
+							return nil
+						},
+					)
+
+					return nil
+				},
+			)
+
+			err := g.Wait()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result.Load()).To(Equal(Concrete1("<concrete>")))
+		})
+
+		It("returns false if the context is not from a wait group", func() {
+			_, ok := imbue.WaitGroupFromContext(context.Background())
+			Expect(ok).To(BeFalse())
+		})
+	})
+
+	Describe("func Go0()", func() {
+		It("calls the function", func() {
+			g := container.WaitGroup(context.Background())
+
+			imbue.Go0(
+				g,
+				func(ctx context.Context) error {
+					return errors.New("<error>")
+				},
+			)
+
+			err := g.Wait()
+			Expect(err).To(
+				MatchError(
+					MatchRegexp(
+						`^goroutine \(waitgroup_test\.go:\d+\) failed: <error>$`,
+					),
+				),
+			)
+		})
+	})
+
+	Describe("func GoX()", func() {
+		It("returns the error from the function", func() {
+			g := container.WaitGroup(context.Background())
+
+			imbue.Go1(
+				g,
+				func(ctx context.Context, dep Concrete1) error {
+					return errors.New("<error>")
+				},
+			)
+
+			err := g.Wait()
+			Expect(err).To(
+				MatchError(
+					MatchRegexp(
+						`^goroutine \(waitgroup_test\.go:\d+\) failed: <error>$`,
+					),
+				),
+			)
+		})
+
+		It("includes the name of the task in the error", func() {
+			g := container.WaitGroup(context.Background())
+
+			imbue.Go1(
+				g,
+				func(ctx context.Context, dep Concrete1) error {
+					return errors.New("<error>")
+				},
+				imbue.TaskName("<task>"),
+			)
+
+			err := g.Wait()
+			Expect(err).To(
+				MatchError(
+					MatchRegexp(
+						`^"<task>" goroutine \(waitgroup_test\.go:\d+\) failed: <error>$`,
+					),
+				),
+			)
+		})
+
+		It("returns an error if the function panics", func() {
+			g := container.WaitGroup(context.Background())
+
+			imbue.Go1(
+				g,
+				func(ctx context.Context, dep Concrete1) error {
+					panic("<panic>")
+				},
+			)
+
+			err := g.Wait()
+			Expect(err).To(
+				MatchError(
+					MatchRegexp(
+						`^goroutine \(waitgroup_test\.go:\d+\) failed: panic: <panic>$`,
+					),
+				),
+			)
+
+			var panicErr imbue.PanicError
+			Expect(errors.As(err, &panicErr)).To(BeTrue())
+			Expect(panicErr.Value).To(Equal("<panic>"))
+			Expect(string(panicErr.Stack)).To(ContainSubstring("waitgroup_test.go"))
+		})
+
+		It("returns an error if a dependency is not declared", func() {
+			g := container.WaitGroup(context.Background())
+
+			imbue.Go1(
+				g,
+				func(ctx context.Context, dep Concrete2) error {
+					panic("unexpected call")
+				},
+			)
+
+			err := g.Wait()
+			Expect(err).To(
+				MatchError(
+					MatchRegexp(
+						`^goroutine \(waitgroup_test\.go:\d+\) failed: panic: no constructor is declared for imbue_test\.Concrete2$`,
+					),
+				),
+			)
+		})
+
+		It("restarts the function when it returns an error", func() {
+			g := container.WaitGroup(context.Background())
+
+			calls := 0
+			imbue.Go1(
+				g,
+				func(ctx context.Context, dep Concrete1) error {
+					Expect(dep).To(Equal(Concrete1("<concrete>")))
+
+					calls++
+					if calls < 3 {
+						return errors.New("<error>")
+					}
+					return nil
+				},
+				imbue.Restart(time.Millisecond),
+			)
+
+			err := g.Wait()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(calls).To(Equal(3))
+		})
+
+		It("does not restart the function more than the maximum number of times", func() {
+			g := container.WaitGroup(context.Background())
+
+			calls := 0
+			imbue.Go1(
+				g,
+				func(ctx context.Context, dep Concrete1) error {
+					calls++
+					return errors.New("<error>")
+				},
+				imbue.RestartOnError(2),
+			)
+
+			err := g.Wait()
+			Expect(errors.Unwrap(err)).To(MatchError("<error>"))
+			Expect(calls).To(Equal(3))
+		})
+
+		It("stops restarting the function when the context is canceled", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			g := container.WaitGroup(ctx)
+
+			calls := 0
+			imbue.Go1(
+				g,
+				func(ctx context.Context, dep Concrete1) error {
+					calls++
+					cancel()
+					return errors.New("<error>")
+				},
+				imbue.Restart(time.Hour),
+			)
+
+			err := g.Wait()
+			Expect(errors.Unwrap(err)).To(MatchError("<error>"))
+			Expect(calls).To(Equal(1))
+		})
+
+		It("does not restart the function when it panics", func() {
+			g := container.WaitGroup(context.Background())
+
+			calls := 0
+			imbue.Go1(
+				g,
+				func(ctx context.Context, dep Concrete1) error {
+					calls++
+					panic("<panic>")
+				},
+				imbue.Restart(0),
+			)
+
+			err := g.Wait()
+			Expect(err).To(
+				MatchError(
+					MatchRegexp(
+						`^goroutine \(waitgroup_test\.go:\d+\) failed: panic: <panic>$`,
+					),
+				),
+			)
+			Expect(calls).To(Equal(1))
+		})
+
+		It("does not restart the function when a dependency is not declared", func() {
+			g := container.WaitGroup(context.Background())
+
+			imbue.Go1(
+				g,
+				func(ctx context.Context, dep Concrete2) error {
+					panic("unexpected call")
+				},
+				imbue.Restart(0),
+			)
+
+			err := g.Wait()
+			Expect(err).To(
+				MatchError(
+					MatchRegexp(
+						`^goroutine \(waitgroup_test\.go:\d+\) failed: panic: no constructor is declared for imbue_test\.Concrete2$`,
+					),
+				),
+			)
+		})
+
+		It("does not return errors from non-critical functions", func() {
+			g := container.WaitGroup(context.Background())
+
+			imbue.Go1(
+				g,
+				func(ctx context.Context, dep Concrete1) error {
+					return errors.New("<error>")
+				},
+				imbue.NonCritical(),
+			)
+
+			imbue.Go1(
+				g,
+				func(ctx context.Context, dep Concrete1) error {
+					time.Sleep(5 * time.Millisecond)
+					return ctx.Err()
+				},
+			)
+
+			err := g.Wait()
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+
+		It("notifies observers when a non-critical function fails", func() {
+			obs := &recordingObserver{}
+			container := imbue.New(imbue.WithObserver(obs))
+			defer container.Close()
+
+			g := container.WaitGroup(context.Background())
+
+			imbue.Go0(
+				g,
+				func(ctx context.Context) error {
+					return errors.New("<error>")
+				},
+				imbue.NonCritical(),
+			)
+
+			err := g.Wait()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(obs.events).To(ConsistOf(
+				MatchRegexp(`^goroutine failed: goroutine \(waitgroup_test\.go:\d+\) failed: <error>$`),
+			))
+		})
+	})
+})
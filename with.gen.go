@@ -9,12 +9,18 @@ func With0[T any](
 	options ...WithOption,
 ) {
 	con.withContainer(func(con *Container) {
+		opts := newWithOptions(options)
+		if !opts.IsEnabled(con) {
+			return
+		}
+
 		t := get[T](con)
 
 		t.Declare(
 			func(ctx Context) (v T, _ error) {
 				return ctor(ctx)
 			},
+			opts,
 		)
 	})
 }
@@ -26,6 +32,11 @@ func With1[T, D any](
 	options ...WithOption,
 ) {
 	con.withContainer(func(con *Container) {
+		opts := newWithOptions(options)
+		if !opts.IsEnabled(con) {
+			return
+		}
+
 		t := get[T](con)
 		d1 := get[D](con)
 
@@ -38,6 +49,7 @@ func With1[T, D any](
 
 				return ctor(ctx, v1)
 			},
+			opts,
 			d1,
 		)
 	})
@@ -50,6 +62,11 @@ func With2[T, D1, D2 any](
 	options ...WithOption,
 ) {
 	con.withContainer(func(con *Container) {
+		opts := newWithOptions(options)
+		if !opts.IsEnabled(con) {
+			return
+		}
+
 		t := get[T](con)
 		d1 := get[D1](con)
 		d2 := get[D2](con)
@@ -68,6 +85,7 @@ func With2[T, D1, D2 any](
 
 				return ctor(ctx, v1, v2)
 			},
+			opts,
 			d1,
 			d2,
 		)
@@ -81,6 +99,11 @@ func With3[T, D1, D2, D3 any](
 	options ...WithOption,
 ) {
 	con.withContainer(func(con *Container) {
+		opts := newWithOptions(options)
+		if !opts.IsEnabled(con) {
+			return
+		}
+
 		t := get[T](con)
 		d1 := get[D1](con)
 		d2 := get[D2](con)
@@ -105,6 +128,7 @@ func With3[T, D1, D2, D3 any](
 
 				return ctor(ctx, v1, v2, v3)
 			},
+			opts,
 			d1,
 			d2,
 			d3,
@@ -119,6 +143,11 @@ func With4[T, D1, D2, D3, D4 any](
 	options ...WithOption,
 ) {
 	con.withContainer(func(con *Container) {
+		opts := newWithOptions(options)
+		if !opts.IsEnabled(con) {
+			return
+		}
+
 		t := get[T](con)
 		d1 := get[D1](con)
 		d2 := get[D2](con)
@@ -149,6 +178,7 @@ func With4[T, D1, D2, D3, D4 any](
 
 				return ctor(ctx, v1, v2, v3, v4)
 			},
+			opts,
 			d1,
 			d2,
 			d3,
@@ -164,6 +194,11 @@ func With5[T, D1, D2, D3, D4, D5 any](
 	options ...WithOption,
 ) {
 	con.withContainer(func(con *Container) {
+		opts := newWithOptions(options)
+		if !opts.IsEnabled(con) {
+			return
+		}
+
 		t := get[T](con)
 		d1 := get[D1](con)
 		d2 := get[D2](con)
@@ -200,6 +235,7 @@ func With5[T, D1, D2, D3, D4, D5 any](
 
 				return ctor(ctx, v1, v2, v3, v4, v5)
 			},
+			opts,
 			d1,
 			d2,
 			d3,
@@ -216,6 +252,11 @@ func With6[T, D1, D2, D3, D4, D5, D6 any](
 	options ...WithOption,
 ) {
 	con.withContainer(func(con *Container) {
+		opts := newWithOptions(options)
+		if !opts.IsEnabled(con) {
+			return
+		}
+
 		t := get[T](con)
 		d1 := get[D1](con)
 		d2 := get[D2](con)
@@ -258,6 +299,7 @@ func With6[T, D1, D2, D3, D4, D5, D6 any](
 
 				return ctor(ctx, v1, v2, v3, v4, v5, v6)
 			},
+			opts,
 			d1,
 			d2,
 			d3,
@@ -275,6 +317,11 @@ func With7[T, D1, D2, D3, D4, D5, D6, D7 any](
 	options ...WithOption,
 ) {
 	con.withContainer(func(con *Container) {
+		opts := newWithOptions(options)
+		if !opts.IsEnabled(con) {
+			return
+		}
+
 		t := get[T](con)
 		d1 := get[D1](con)
 		d2 := get[D2](con)
@@ -323,6 +370,7 @@ func With7[T, D1, D2, D3, D4, D5, D6, D7 any](
 
 				return ctor(ctx, v1, v2, v3, v4, v5, v6, v7)
 			},
+			opts,
 			d1,
 			d2,
 			d3,
@@ -341,6 +389,11 @@ func With8[T, D1, D2, D3, D4, D5, D6, D7, D8 any](
 	options ...WithOption,
 ) {
 	con.withContainer(func(con *Container) {
+		opts := newWithOptions(options)
+		if !opts.IsEnabled(con) {
+			return
+		}
+
 		t := get[T](con)
 		d1 := get[D1](con)
 		d2 := get[D2](con)
@@ -395,6 +448,7 @@ func With8[T, D1, D2, D3, D4, D5, D6, D7, D8 any](
 
 				return ctor(ctx, v1, v2, v3, v4, v5, v6, v7, v8)
 			},
+			opts,
 			d1,
 			d2,
 			d3,
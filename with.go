@@ -1,6 +1,68 @@
 package imbue
 
+import "time"
+
 // WithOption is an option that changes the behavior of a call to WithX().
 type WithOption interface {
-	applyWithOption()
+	applyWithOption(*withOptions)
+}
+
+// DeclarationOption is an option that can be passed to any of the WithX(),
+// WithXNamed() and WithXGrouped() functions.
+type DeclarationOption interface {
+	WithOption
+	WithNamedOption
+	WithGroupedOption
+}
+
+// withOptions is the set of options that apply to a single call to WithX().
+type withOptions struct {
+	// conditions is a set of predicates that must all return true for the
+	// declaration to be added to the container.
+	conditions []func(*Container) bool
+
+	// isEager indicates whether the value should be constructed when the
+	// container is built.
+	isEager bool
+
+	// timeout is the maximum amount of time that the constructor and
+	// decorators may take to construct the value. A value of zero means
+	// there is no limit.
+	timeout time.Duration
+
+	// retry is the policy that determines how construction of the value is
+	// retried if it fails.
+	retry RetryPolicy
+}
+
+// newWithOptions returns the withOptions described by the given options.
+func newWithOptions(options []WithOption) withOptions {
+	var opts withOptions
+
+	for _, opt := range options {
+		opt.applyWithOption(&opts)
+	}
+
+	return opts
+}
+
+// IsEnabled returns true if the declaration should be added to con.
+func (o withOptions) IsEnabled(con *Container) bool {
+	for _, cond := range o.conditions {
+		if !cond(con) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Eager is a DeclarationOption that causes the value to be constructed when
+// Container.Build() is called, rather than when it is first needed.
+func Eager() DeclarationOption {
+	return option{
+		forWith: func(opts *withOptions) {
+			opts.isEager = true
+		},
+	}
 }
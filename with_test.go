@@ -234,7 +234,7 @@ var _ = Describe("func WithX()", func() {
 		}).To(
 			PanicWith(
 				MatchRegexp(
-					`imbue_test\.Concrete1 constructor \(with_test\.go:\d+\) depends on itself`,
+					`imbue_test\.Concrete1 constructor \(with_test\.go:\d+\) depends on itself via parameter 2$`,
 				),
 			),
 		)
@@ -275,9 +275,9 @@ var _ = Describe("func WithX()", func() {
 			PanicWith(
 				MatchRegexp(
 					`imbue_test\.Concrete3 constructor \(with_test\.go:\d+\) introduces a cyclic dependency:` +
-						`\n\t-> imbue_test\.Concrete2 \(with_test\.go:\d+\)` +
-						`\n\t-> imbue_test\.Concrete1 \(with_test\.go:\d+\)` +
-						`\n\t-> imbue_test\.Concrete3 \(with_test\.go:\d+\)`,
+						`\n\t-> imbue_test\.Concrete2 \(with_test\.go:\d+\), via parameter 2 \(imbue_test\.Concrete2\) of imbue_test\.Concrete3 constructor \(with_test\.go:\d+\)` +
+						`\n\t-> imbue_test\.Concrete1 \(with_test\.go:\d+\), via parameter 2 \(imbue_test\.Concrete1\) of imbue_test\.Concrete2 constructor \(with_test\.go:\d+\)` +
+						`\n\t-> imbue_test\.Concrete3 \(with_test\.go:\d+\), via parameter 2 \(imbue_test\.Concrete3\) of imbue_test\.Concrete1 constructor \(with_test\.go:\d+\)$`,
 				),
 			),
 		)
@@ -18,6 +18,7 @@ func With0Grouped[G Group, T any](
 				v, err := ctor(ctx)
 				return inGroup[G](v), err
 			},
+			withGroupedOptions(options)...,
 		)
 	})
 }
@@ -39,6 +40,7 @@ func With1Grouped[G Group, T, D any](
 				v, err := ctor(ctx, v1)
 				return inGroup[G](v), err
 			},
+			withGroupedOptions(options)...,
 		)
 	})
 }
@@ -60,6 +62,7 @@ func With2Grouped[G Group, T, D1, D2 any](
 				v, err := ctor(ctx, v1, v2)
 				return inGroup[G](v), err
 			},
+			withGroupedOptions(options)...,
 		)
 	})
 }
@@ -81,6 +84,7 @@ func With3Grouped[G Group, T, D1, D2, D3 any](
 				v, err := ctor(ctx, v1, v2, v3)
 				return inGroup[G](v), err
 			},
+			withGroupedOptions(options)...,
 		)
 	})
 }
@@ -102,6 +106,7 @@ func With4Grouped[G Group, T, D1, D2, D3, D4 any](
 				v, err := ctor(ctx, v1, v2, v3, v4)
 				return inGroup[G](v), err
 			},
+			withGroupedOptions(options)...,
 		)
 	})
 }
@@ -123,6 +128,7 @@ func With5Grouped[G Group, T, D1, D2, D3, D4, D5 any](
 				v, err := ctor(ctx, v1, v2, v3, v4, v5)
 				return inGroup[G](v), err
 			},
+			withGroupedOptions(options)...,
 		)
 	})
 }
@@ -144,6 +150,7 @@ func With6Grouped[G Group, T, D1, D2, D3, D4, D5, D6 any](
 				v, err := ctor(ctx, v1, v2, v3, v4, v5, v6)
 				return inGroup[G](v), err
 			},
+			withGroupedOptions(options)...,
 		)
 	})
 }
@@ -165,6 +172,7 @@ func With7Grouped[G Group, T, D1, D2, D3, D4, D5, D6, D7 any](
 				v, err := ctor(ctx, v1, v2, v3, v4, v5, v6, v7)
 				return inGroup[G](v), err
 			},
+			withGroupedOptions(options)...,
 		)
 	})
 }
@@ -186,6 +194,7 @@ func With8Grouped[G Group, T, D1, D2, D3, D4, D5, D6, D7, D8 any](
 				v, err := ctor(ctx, v1, v2, v3, v4, v5, v6, v7, v8)
 				return inGroup[G](v), err
 			},
+			withGroupedOptions(options)...,
 		)
 	})
 }
@@ -30,6 +30,26 @@ func (v FromGroup[G, T]) Value() T {
 	return v.value
 }
 
+// unwrap returns the dependency value and its group.
+//
+// It implements the wrapper interface.
+func (v FromGroup[G, T]) unwrap() unwrapped {
+	return unwrapped{
+		Value: v.value,
+		Type:  typeOf[T](),
+		Group: v.Group(),
+	}
+}
+
+// rewrap returns a copy of the wrapper that contains v in place of its current
+// value.
+//
+// It implements the wrapper interface.
+func (v FromGroup[G, T]) rewrap(x any) (wrapper, bool) {
+	t, ok := x.(T)
+	return inGroup[G](t), ok
+}
+
 // inGroup wraps a value of type T to present it as a FromGroup[G, T].
 func inGroup[G Group, T any](v T) FromGroup[G, T] {
 	return FromGroup[G, T]{
@@ -40,5 +60,17 @@ func inGroup[G Group, T any](v T) FromGroup[G, T] {
 // WithGroupedOption is an option that changes the behavior of a call to
 // WithXGrouped().
 type WithGroupedOption interface {
-	applyWithGroupedOption()
+	applyWithGroupedOption(*withOptions)
+}
+
+// withGroupedOptions adapts options passed to WithXGrouped() so that they can
+// be forwarded to WithX().
+func withGroupedOptions(options []WithGroupedOption) []WithOption {
+	result := make([]WithOption, 0, len(options))
+
+	for _, opt := range options {
+		result = append(result, option{forWith: opt.applyWithGroupedOption})
+	}
+
+	return result
 }
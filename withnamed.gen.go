@@ -18,6 +18,7 @@ func With0Named[N Name[T], T any](
 				v, err := ctor(ctx)
 				return withName[N](v), err
 			},
+			withNamedOptions(options)...,
 		)
 	})
 }
@@ -39,6 +40,7 @@ func With1Named[N Name[T], T, D any](
 				v, err := ctor(ctx, v1)
 				return withName[N](v), err
 			},
+			withNamedOptions(options)...,
 		)
 	})
 }
@@ -60,6 +62,7 @@ func With2Named[N Name[T], T, D1, D2 any](
 				v, err := ctor(ctx, v1, v2)
 				return withName[N](v), err
 			},
+			withNamedOptions(options)...,
 		)
 	})
 }
@@ -81,6 +84,7 @@ func With3Named[N Name[T], T, D1, D2, D3 any](
 				v, err := ctor(ctx, v1, v2, v3)
 				return withName[N](v), err
 			},
+			withNamedOptions(options)...,
 		)
 	})
 }
@@ -102,6 +106,7 @@ func With4Named[N Name[T], T, D1, D2, D3, D4 any](
 				v, err := ctor(ctx, v1, v2, v3, v4)
 				return withName[N](v), err
 			},
+			withNamedOptions(options)...,
 		)
 	})
 }
@@ -123,6 +128,7 @@ func With5Named[N Name[T], T, D1, D2, D3, D4, D5 any](
 				v, err := ctor(ctx, v1, v2, v3, v4, v5)
 				return withName[N](v), err
 			},
+			withNamedOptions(options)...,
 		)
 	})
 }
@@ -144,6 +150,7 @@ func With6Named[N Name[T], T, D1, D2, D3, D4, D5, D6 any](
 				v, err := ctor(ctx, v1, v2, v3, v4, v5, v6)
 				return withName[N](v), err
 			},
+			withNamedOptions(options)...,
 		)
 	})
 }
@@ -165,6 +172,7 @@ func With7Named[N Name[T], T, D1, D2, D3, D4, D5, D6, D7 any](
 				v, err := ctor(ctx, v1, v2, v3, v4, v5, v6, v7)
 				return withName[N](v), err
 			},
+			withNamedOptions(options)...,
 		)
 	})
 }
@@ -186,6 +194,7 @@ func With8Named[N Name[T], T, D1, D2, D3, D4, D5, D6, D7, D8 any](
 				v, err := ctor(ctx, v1, v2, v3, v4, v5, v6, v7, v8)
 				return withName[N](v), err
 			},
+			withNamedOptions(options)...,
 		)
 	})
 }
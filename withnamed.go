@@ -29,6 +29,26 @@ func (v ByName[N, T]) Value() T {
 	return v.value
 }
 
+// unwrap returns the dependency value and its name.
+//
+// It implements the wrapper interface.
+func (v ByName[N, T]) unwrap() unwrapped {
+	return unwrapped{
+		Value: v.value,
+		Type:  typeOf[T](),
+		Name:  v.Name(),
+	}
+}
+
+// rewrap returns a copy of the wrapper that contains v in place of its current
+// value.
+//
+// It implements the wrapper interface.
+func (v ByName[N, T]) rewrap(x any) (wrapper, bool) {
+	t, ok := x.(T)
+	return withName[N](t), ok
+}
+
 // withName wraps a value of type T to present it as a ByName[N, T].
 func withName[N Name[T], T any](v T) ByName[N, T] {
 	return ByName[N, T]{
@@ -39,5 +59,17 @@ func withName[N Name[T], T any](v T) ByName[N, T] {
 // WithNamedOption is an option that changes the behavior of a call to
 // WithXNamed().
 type WithNamedOption interface {
-	applyWithNamedOption()
+	applyWithNamedOption(*withOptions)
+}
+
+// withNamedOptions adapts options passed to WithXNamed() so that they can be
+// forwarded to WithX().
+func withNamedOptions(options []WithNamedOption) []WithOption {
+	result := make([]WithOption, 0, len(options))
+
+	for _, opt := range options {
+		result = append(result, option{forWith: opt.applyWithNamedOption})
+	}
+
+	return result
 }
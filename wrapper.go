@@ -0,0 +1,21 @@
+package imbue
+
+import "reflect"
+
+// wrapper is an interface for types that wrap a dependency value to give it a
+// name or place it within a group.
+type wrapper interface {
+	unwrap() unwrapped
+
+	// rewrap returns a copy of the wrapper that contains v in place of its
+	// current value. It returns false if v is not of the wrapped type.
+	rewrap(v any) (wrapper, bool)
+}
+
+// unwrapped is a dependency value that has been extracted from a wrapper.
+type unwrapped struct {
+	Value any
+	Type  reflect.Type
+	Name  string
+	Group string
+}